@@ -0,0 +1,534 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/pool"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/rs/zerolog/log"
+)
+
+var errNotFound = errors.New("not found")
+
+type BlockStorer interface {
+	GetLastStoredBlock(domainID uint8) (*big.Int, error)
+}
+
+type MessageOutbox interface {
+	StoreMessages(block *big.Int, msgs []*message.Message) error
+	PendingMessages(source uint8) ([]*message.Message, error)
+	MarkDone(m *message.Message) error
+}
+
+type DeadLetterStorer interface {
+	DeadLetters(destination uint8) ([]*store.DeadLetter, error)
+	StoreDeadLetter(m *message.Message, err error, attempts int) error
+	DeleteDeadLetter(m *message.Message) error
+}
+
+type MessageSubmitter interface {
+	Submit(ctx context.Context, msgs []*message.Message) error
+}
+
+type TransactionMonitor interface {
+	PendingTransactions() []monitored.PendingTransaction
+}
+
+type KeyMonitor interface {
+	Keys(ctx context.Context) ([]pool.KeyStatus, error)
+}
+
+type DepositFinder interface {
+	FindDeposit(ctx context.Context, destination uint8, depositNonce uint64) (*message.Message, error)
+}
+
+// Server is an HTTP/JSON API that lets operators inspect a running relayer
+// and retry, skip or inject messages.
+type Server struct {
+	address     string
+	blockstore  BlockStorer
+	outbox      MessageOutbox
+	deadLetters DeadLetterStorer
+	submitter   MessageSubmitter
+	authToken   string
+
+	domainsLock    sync.RWMutex
+	domains        map[uint8]TransactionMonitor
+	keys           map[uint8]KeyMonitor
+	depositFinders map[uint8]DepositFinder
+}
+
+func NewServer(address string, blockstore BlockStorer, outbox MessageOutbox, deadLetters DeadLetterStorer, submitter MessageSubmitter) *Server {
+	return &Server{
+		address:        address,
+		blockstore:     blockstore,
+		outbox:         outbox,
+		deadLetters:    deadLetters,
+		submitter:      submitter,
+		domains:        make(map[uint8]TransactionMonitor),
+		keys:           make(map[uint8]KeyMonitor),
+		depositFinders: make(map[uint8]DepositFinder),
+	}
+}
+
+// RegisterDomain exposes domain through the API. Monitor is optional
+// and provides transactions pending on the domain.
+func (s *Server) RegisterDomain(domainID uint8, monitor TransactionMonitor) {
+	s.domainsLock.Lock()
+	defer s.domainsLock.Unlock()
+
+	s.domains[domainID] = monitor
+}
+
+// RegisterKeys exposes balances and pending transactions of signing keys of the domain
+func (s *Server) RegisterKeys(domainID uint8, keys KeyMonitor) {
+	s.domainsLock.Lock()
+	defer s.domainsLock.Unlock()
+
+	s.keys[domainID] = keys
+}
+
+// RegisterDepositFinder enables injecting messages of deposits made on the source domain
+func (s *Server) RegisterDepositFinder(domainID uint8, finder DepositFinder) {
+	s.domainsLock.Lock()
+	defer s.domainsLock.Unlock()
+
+	s.depositFinders[domainID] = finder
+}
+
+// RemoveDomain stops exposing domain through the API
+func (s *Server) RemoveDomain(domainID uint8) {
+	s.domainsLock.Lock()
+	defer s.domainsLock.Unlock()
+
+	delete(s.domains, domainID)
+	delete(s.keys, domainID)
+	delete(s.depositFinders, domainID)
+}
+
+// RegisterAuthToken requires requests to carry token as a bearer token.
+// Without a token the server only listens on a loopback address.
+func (s *Server) RegisterAuthToken(token string) {
+	s.authToken = token
+}
+
+// Start serves the API until ctx is done. Failure to serve is sent to sysErr.
+func (s *Server) Start(ctx context.Context, sysErr chan<- error) {
+	if s.authToken == "" && !isLoopback(s.address) {
+		sysErr <- fmt.Errorf("API server without auth token can't listen on non-loopback address %s", s.address)
+		return
+	}
+
+	server := &http.Server{
+		Addr:              s.address,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: time.Second * 10,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("Failed shutting down API server")
+		}
+	}()
+
+	log.Info().Msgf("Starting API server on %s", s.address)
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		sysErr <- fmt.Errorf("API server failed: %w", err)
+	}
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/domains", s.handleDomains)
+	mux.HandleFunc("/domains/", s.handleDomain)
+	mux.HandleFunc("/messages/retry", s.handleRetry)
+	mux.HandleFunc("/messages/skip", s.handleSkip)
+	mux.HandleFunc("/messages/inject", s.handleInject)
+	return s.authenticate(mux)
+}
+
+// authenticate rejects requests without the auth token, if one is registered
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.authToken != "" {
+			token, ok := bearerToken(r)
+			if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.authToken)) != 1 {
+				writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken returns token from the "Authorization: Bearer <token>" header
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(header, prefix), true
+}
+
+// handleDomains returns listener positions of all domains
+func (s *Server) handleDomains(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	domains := make([]Domain, 0)
+	for _, domainID := range s.domainIDs() {
+		block, err := s.blockstore.GetLastStoredBlock(domainID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		domains = append(domains, newDomain(domainID, block))
+	}
+	writeJSON(w, http.StatusOK, domains)
+}
+
+// handleDomain serves /domains/{id}/messages/pending, /domains/{id}/messages/failed
+// /domains/{id}/transactions and /domains/{id}/keys
+func (s *Server) handleDomain(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/domains/"), "/"), "/")
+	id, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid domain ID %s", parts[0]))
+		return
+	}
+	domainID := uint8(id)
+	monitor, ok := s.domain(domainID)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("domain %d not registered", domainID))
+		return
+	}
+
+	switch strings.Join(parts[1:], "/") {
+	case "messages/pending":
+		s.pendingMessages(w, domainID)
+	case "messages/failed":
+		s.failedMessages(w, domainID)
+	case "transactions":
+		s.pendingTransactions(w, monitor)
+	case "keys":
+		s.domainKeys(w, r, domainID)
+	default:
+		writeError(w, http.StatusNotFound, errNotFound)
+	}
+}
+
+// pendingMessages returns messages read from source domain that are not executed yet
+func (s *Server) pendingMessages(w http.ResponseWriter, source uint8) {
+	msgs, err := s.outbox.PendingMessages(source)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := make([]Message, len(msgs))
+	for i, m := range msgs {
+		resp[i] = newMessage(m)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// failedMessages returns dead lettered messages of destination domain
+func (s *Server) failedMessages(w http.ResponseWriter, destination uint8) {
+	deadLetters, err := s.deadLetters.DeadLetters(destination)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := make([]FailedMessage, len(deadLetters))
+	for i, dl := range deadLetters {
+		resp[i] = newFailedMessage(dl)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) pendingTransactions(w http.ResponseWriter, monitor TransactionMonitor) {
+	resp := make([]Transaction, 0)
+	if monitor != nil {
+		for _, tx := range monitor.PendingTransactions() {
+			resp = append(resp, newTransaction(tx))
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// domainKeys returns balances and pending transaction counts of domain signing keys
+func (s *Server) domainKeys(w http.ResponseWriter, r *http.Request, domainID uint8) {
+	s.domainsLock.RLock()
+	keys, ok := s.keys[domainID]
+	s.domainsLock.RUnlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, errNotFound)
+		return
+	}
+
+	statuses, err := keys.Keys(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := make([]Key, len(statuses))
+	for i, status := range statuses {
+		resp[i] = newKey(status)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// handleRetry moves failed message from dead letters back to the outbox and
+// submits it for execution again
+func (s *Server) handleRetry(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	var id MessageID
+	if !readJSON(w, r, &id) {
+		return
+	}
+
+	dl, err := s.deadLetter(id)
+	if err != nil {
+		writeLookupError(w, err)
+		return
+	}
+
+	err = s.storeSubmitted(dl.Message)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	err = s.deadLetters.DeleteDeadLetter(dl.Message)
+	if err != nil {
+		s.removeSubmitted(dl.Message)
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	err = s.submitter.Submit(r.Context(), []*message.Message{dl.Message})
+	if err != nil {
+		// keep the message in dead letters so it can be retried later
+		if storeErr := s.deadLetters.StoreDeadLetter(dl.Message, errors.New(dl.Error), dl.Attempts); storeErr != nil {
+			log.Error().Err(storeErr).Msgf("Failed restoring dead letter %+v", dl.Message)
+		}
+		s.removeSubmitted(dl.Message)
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	log.Info().Msgf("Retrying message %+v", dl.Message)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleSkip discards message from dead letters and the outbox so it is never executed
+func (s *Server) handleSkip(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	var id MessageID
+	if !readJSON(w, r, &id) {
+		return
+	}
+
+	m := &message.Message{Source: id.Source, Destination: id.Destination, DepositNonce: id.DepositNonce}
+	err := s.deadLetters.DeleteDeadLetter(m)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	err = s.outbox.MarkDone(m)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	log.Info().Msgf("Skipped message %+v", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleInject submits message of a deposit the listener missed for execution.
+// Message is rebuilt from the deposit event on the source domain.
+func (s *Server) handleInject(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	var id MessageID
+	if !readJSON(w, r, &id) {
+		return
+	}
+
+	if _, ok := s.domain(id.Destination); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("destination domain %d not registered", id.Destination))
+		return
+	}
+	s.domainsLock.RLock()
+	finder, ok := s.depositFinders[id.Source]
+	s.domainsLock.RUnlock()
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("source domain %d not registered", id.Source))
+		return
+	}
+
+	m, err := finder.FindDeposit(r.Context(), id.Destination, id.DepositNonce)
+	if errors.Is(err, listener.ErrDepositNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = s.storeSubmitted(m)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	err = s.submitter.Submit(r.Context(), []*message.Message{m})
+	if err != nil {
+		s.removeSubmitted(m)
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	log.Info().Msgf("Injected message %+v", m)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// storeSubmitted persists message submitted by an operator in the outbox so it
+// is redelivered if the relayer restarts before it is executed. Message is stored
+// with block 0 as it wasn't read by the listener and is never retracted.
+func (s *Server) storeSubmitted(m *message.Message) error {
+	return s.outbox.StoreMessages(big.NewInt(0), []*message.Message{m})
+}
+
+// removeSubmitted removes message that failed to be submitted from the outbox
+func (s *Server) removeSubmitted(m *message.Message) {
+	if err := s.outbox.MarkDone(m); err != nil {
+		log.Error().Err(err).Msgf("Failed removing message %+v from outbox", m)
+	}
+}
+
+func (s *Server) deadLetter(id MessageID) (*store.DeadLetter, error) {
+	deadLetters, err := s.deadLetters.DeadLetters(id.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dl := range deadLetters {
+		if dl.Message.Source == id.Source && dl.Message.DepositNonce == id.DepositNonce {
+			return dl, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (s *Server) domain(domainID uint8) (TransactionMonitor, bool) {
+	s.domainsLock.RLock()
+	defer s.domainsLock.RUnlock()
+
+	monitor, ok := s.domains[domainID]
+	return monitor, ok
+}
+
+func (s *Server) domainIDs() []uint8 {
+	s.domainsLock.RLock()
+	defer s.domainsLock.RUnlock()
+
+	ids := make([]uint8, 0, len(s.domains))
+	for id := range s.domains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// isLoopback checks if address listens only on a loopback interface
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return false
+	}
+	return true
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error().Err(err).Msg("Failed writing API response")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeStatus(w http.ResponseWriter, err error) {
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, statusResponse{Status: "ok"})
+}
+
+func writeLookupError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, err)
+}
@@ -0,0 +1,292 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/api"
+	mock_api "github.com/ChainSafe/chainbridge-core/api/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/pool"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServerTestSuite struct {
+	suite.Suite
+	server                 *api.Server
+	mockBlockStorer        *mock_api.MockBlockStorer
+	mockOutbox             *mock_api.MockMessageOutbox
+	mockDeadLetters        *mock_api.MockDeadLetterStorer
+	mockSubmitter          *mock_api.MockMessageSubmitter
+	mockTransactionMonitor *mock_api.MockTransactionMonitor
+	mockKeyMonitor         *mock_api.MockKeyMonitor
+	mockDepositFinder      *mock_api.MockDepositFinder
+}
+
+func TestRunServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+func (s *ServerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockBlockStorer = mock_api.NewMockBlockStorer(gomockController)
+	s.mockOutbox = mock_api.NewMockMessageOutbox(gomockController)
+	s.mockDeadLetters = mock_api.NewMockDeadLetterStorer(gomockController)
+	s.mockSubmitter = mock_api.NewMockMessageSubmitter(gomockController)
+	s.mockTransactionMonitor = mock_api.NewMockTransactionMonitor(gomockController)
+	s.mockKeyMonitor = mock_api.NewMockKeyMonitor(gomockController)
+	s.mockDepositFinder = mock_api.NewMockDepositFinder(gomockController)
+	s.server = api.NewServer("", s.mockBlockStorer, s.mockOutbox, s.mockDeadLetters, s.mockSubmitter)
+	s.server.RegisterDomain(1, s.mockTransactionMonitor)
+	s.server.RegisterDomain(2, nil)
+	s.server.RegisterKeys(1, s.mockKeyMonitor)
+	s.server.RegisterDepositFinder(1, s.mockDepositFinder)
+}
+
+func (s *ServerTestSuite) request(method string, path string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.server.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *ServerTestSuite) TestDomains_ReturnsListenerPositions() {
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(1)).Return(big.NewInt(100), nil)
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(2)).Return(big.NewInt(200), nil)
+
+	rec := s.request(http.MethodGet, "/domains", "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var domains []api.Domain
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &domains))
+	s.Equal([]api.Domain{{DomainID: 1, LastBlock: "100"}, {DomainID: 2, LastBlock: "200"}}, domains)
+}
+
+func (s *ServerTestSuite) TestDomain_UnknownDomain() {
+	rec := s.request(http.MethodGet, "/domains/3/messages/pending", "")
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *ServerTestSuite) TestPendingMessages_ReturnsOutboxMessages() {
+	s.mockOutbox.EXPECT().PendingMessages(uint8(1)).Return([]*message.Message{
+		{Source: 1, Destination: 2, DepositNonce: 3, Type: message.FungibleTransfer, Payload: []interface{}{[]byte{1}, []byte{2}}},
+	}, nil)
+
+	rec := s.request(http.MethodGet, "/domains/1/messages/pending", "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var msgs []api.Message
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &msgs))
+	s.Len(msgs, 1)
+	s.Equal(uint64(3), msgs[0].DepositNonce)
+	s.Equal([]string{"0x01", "0x02"}, msgs[0].Payload)
+}
+
+func (s *ServerTestSuite) TestFailedMessages_ReturnsDeadLetters() {
+	s.mockDeadLetters.EXPECT().DeadLetters(uint8(2)).Return([]*store.DeadLetter{
+		{Message: &message.Message{Source: 1, Destination: 2, DepositNonce: 3}, Error: "error", Attempts: 5},
+	}, nil)
+
+	rec := s.request(http.MethodGet, "/domains/2/messages/failed", "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var msgs []api.FailedMessage
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &msgs))
+	s.Len(msgs, 1)
+	s.Equal("error", msgs[0].Error)
+	s.Equal(5, msgs[0].Attempts)
+}
+
+func (s *ServerTestSuite) TestTransactions_ReturnsPendingTransactions() {
+	s.mockTransactionMonitor.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{
+		{Hash: common.Hash{1}, Nonce: 4, GasPrice: []*big.Int{big.NewInt(10)}},
+	})
+
+	rec := s.request(http.MethodGet, "/domains/1/transactions", "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var txs []api.Transaction
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &txs))
+	s.Len(txs, 1)
+	s.Equal(uint64(4), txs[0].Nonce)
+	s.Equal([]string{"10"}, txs[0].GasPrice)
+}
+
+func (s *ServerTestSuite) TestKeys_ReturnsKeyStatuses() {
+	s.mockKeyMonitor.EXPECT().Keys(gomock.Any()).Return([]pool.KeyStatus{
+		{Address: common.HexToAddress("0x1"), Balance: big.NewInt(100), Pending: 2},
+	}, nil)
+
+	rec := s.request(http.MethodGet, "/domains/1/keys", "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var keys []api.Key
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &keys))
+	s.Len(keys, 1)
+	s.Equal(common.HexToAddress("0x1").Hex(), keys[0].Address)
+	s.Equal("100", keys[0].Balance)
+	s.Equal(2, keys[0].Pending)
+}
+
+func (s *ServerTestSuite) TestKeys_NotRegistered() {
+	rec := s.request(http.MethodGet, "/domains/2/keys", "")
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *ServerTestSuite) TestRetry_SubmitsDeadLetter() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	s.mockDeadLetters.EXPECT().DeadLetters(uint8(2)).Return([]*store.DeadLetter{{Message: m}}, nil)
+	s.mockOutbox.EXPECT().StoreMessages(big.NewInt(0), []*message.Message{m}).Return(nil)
+	s.mockDeadLetters.EXPECT().DeleteDeadLetter(m).Return(nil)
+	s.mockSubmitter.EXPECT().Submit(gomock.Any(), []*message.Message{m}).Return(nil)
+
+	rec := s.request(http.MethodPost, "/messages/retry", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
+func (s *ServerTestSuite) TestRetry_RestoresDeadLetterIfSubmitFails() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	s.mockDeadLetters.EXPECT().DeadLetters(uint8(2)).Return([]*store.DeadLetter{{Message: m, Error: "error", Attempts: 2}}, nil)
+	s.mockOutbox.EXPECT().StoreMessages(big.NewInt(0), []*message.Message{m}).Return(nil)
+	s.mockDeadLetters.EXPECT().DeleteDeadLetter(m).Return(nil)
+	s.mockSubmitter.EXPECT().Submit(gomock.Any(), []*message.Message{m}).Return(errors.New("error"))
+	s.mockDeadLetters.EXPECT().StoreDeadLetter(m, gomock.Any(), 2).Return(nil)
+	s.mockOutbox.EXPECT().MarkDone(m).Return(nil)
+
+	rec := s.request(http.MethodPost, "/messages/retry", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func (s *ServerTestSuite) TestRetry_KeepsDeadLetterIfOutboxStoreFails() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	s.mockDeadLetters.EXPECT().DeadLetters(uint8(2)).Return([]*store.DeadLetter{{Message: m}}, nil)
+	s.mockOutbox.EXPECT().StoreMessages(big.NewInt(0), []*message.Message{m}).Return(errors.New("error"))
+
+	rec := s.request(http.MethodPost, "/messages/retry", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func (s *ServerTestSuite) TestRetry_UnknownMessage() {
+	s.mockDeadLetters.EXPECT().DeadLetters(uint8(2)).Return([]*store.DeadLetter{}, nil)
+
+	rec := s.request(http.MethodPost, "/messages/retry", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *ServerTestSuite) TestSkip_RemovesMessage() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	s.mockDeadLetters.EXPECT().DeleteDeadLetter(m).Return(nil)
+	s.mockOutbox.EXPECT().MarkDone(m).Return(nil)
+
+	rec := s.request(http.MethodPost, "/messages/skip", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ServerTestSuite) TestInject_SubmitsMessageOfDeposit() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3, Payload: []interface{}{[]byte{1}, []byte{2}}}
+	s.mockDepositFinder.EXPECT().FindDeposit(gomock.Any(), uint8(2), uint64(3)).Return(m, nil)
+	s.mockOutbox.EXPECT().StoreMessages(big.NewInt(0), []*message.Message{m}).Return(nil)
+	s.mockSubmitter.EXPECT().Submit(gomock.Any(), []*message.Message{m}).Return(nil)
+
+	rec := s.request(http.MethodPost, "/messages/inject", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
+func (s *ServerTestSuite) TestInject_RemovesMessageFromOutboxIfSubmitFails() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	s.mockDepositFinder.EXPECT().FindDeposit(gomock.Any(), uint8(2), uint64(3)).Return(m, nil)
+	s.mockOutbox.EXPECT().StoreMessages(big.NewInt(0), []*message.Message{m}).Return(nil)
+	s.mockSubmitter.EXPECT().Submit(gomock.Any(), []*message.Message{m}).Return(errors.New("error"))
+	s.mockOutbox.EXPECT().MarkDone(m).Return(nil)
+
+	rec := s.request(http.MethodPost, "/messages/inject", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func (s *ServerTestSuite) TestInject_DepositNotFound() {
+	s.mockDepositFinder.EXPECT().FindDeposit(gomock.Any(), uint8(2), uint64(3)).Return(nil, listener.ErrDepositNotFound)
+
+	rec := s.request(http.MethodPost, "/messages/inject", `{"source":1,"destination":2,"depositNonce":3}`)
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *ServerTestSuite) TestInject_UnknownSource() {
+	rec := s.request(http.MethodPost, "/messages/inject", `{"source":2,"destination":1,"depositNonce":3}`)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ServerTestSuite) TestAuthToken_RejectsRequestWithoutToken() {
+	s.server.RegisterAuthToken("secret")
+
+	rec := s.request(http.MethodGet, "/domains/1/transactions", "")
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (s *ServerTestSuite) TestAuthToken_AcceptsRequestWithToken() {
+	s.server.RegisterAuthToken("secret")
+	s.mockTransactionMonitor.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{})
+
+	req := httptest.NewRequest(http.MethodGet, "/domains/1/transactions", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	s.server.Handler().ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ServerTestSuite) TestAuthToken_RejectsTokenWithoutBearerScheme() {
+	s.server.RegisterAuthToken("secret")
+
+	req := httptest.NewRequest(http.MethodGet, "/domains/1/transactions", nil)
+	req.Header.Set("Authorization", "secret")
+	rec := httptest.NewRecorder()
+	s.server.Handler().ServeHTTP(rec, req)
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (s *ServerTestSuite) TestStart_RefusesNonLoopbackAddressWithoutToken() {
+	server := api.NewServer("0.0.0.0:8080", s.mockBlockStorer, s.mockOutbox, s.mockDeadLetters, s.mockSubmitter)
+	sysErr := make(chan error, 1)
+
+	server.Start(context.Background(), sysErr)
+
+	s.NotNil(<-sysErr)
+}
+
+func (s *ServerTestSuite) TestMethodNotAllowed() {
+	rec := s.request(http.MethodGet, "/messages/retry", "")
+
+	s.Equal(http.StatusMethodNotAllowed, rec.Code)
+}
+
+func (s *ServerTestSuite) TestRemoveDomain() {
+	s.server.RemoveDomain(1)
+
+	rec := s.request(http.MethodGet, "/domains/1/transactions", "")
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,251 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultDepositsLimit = 100
+	maxDepositsLimit     = 1000
+)
+
+type DepositStorer interface {
+	GetDeposit(source uint8, destination uint8, depositNonce uint64) (*store.Deposit, error)
+	DepositsByNonce(source uint8, depositNonce uint64) ([]*store.Deposit, error)
+	Deposits(source uint8, destination uint8, after uint64, limit int) ([]*store.Deposit, error)
+	DepositsByTxHash(txHash common.Hash) ([]*store.Deposit, error)
+	RouteStats(source uint8, destination uint8) (*store.RouteStats, error)
+}
+
+// Explorer is a read-only HTTP/JSON API over deposits seen by the relayer.
+// It is served separately from the admin API so it can be exposed to
+// users that must not manage the relayer.
+type Explorer struct {
+	address  string
+	deposits DepositStorer
+}
+
+func NewExplorer(address string, deposits DepositStorer) *Explorer {
+	return &Explorer{
+		address:  address,
+		deposits: deposits,
+	}
+}
+
+// Start serves the API until ctx is done. Failure to serve is sent to sysErr.
+func (e *Explorer) Start(ctx context.Context, sysErr chan<- error) {
+	server := &http.Server{
+		Addr:              e.address,
+		Handler:           e.Handler(),
+		ReadHeaderTimeout: time.Second * 10,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("Failed shutting down explorer API server")
+		}
+	}()
+
+	log.Info().Msgf("Starting explorer API server on %s", e.address)
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		sysErr <- fmt.Errorf("explorer API server failed: %w", err)
+	}
+}
+
+func (e *Explorer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/deposits", e.handleDeposits)
+	mux.HandleFunc("/deposits/by-tx/", e.handleDepositsByTx)
+	mux.HandleFunc("/routes/", e.handleRoute)
+	return mux
+}
+
+// handleDeposits returns deposits from the source to the destination domain
+// ordered by deposit nonce. Deposits are paged with limit and after, the
+// deposit nonce of the last deposit of the previous page, or filtered by nonce.
+// Deposits filtered by nonce without destination are returned for all routes.
+func (e *Explorer) handleDeposits(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	query := r.URL.Query()
+	if query.Get("source") == "" {
+		writeError(w, http.StatusBadRequest, errors.New("source is required"))
+		return
+	}
+	source, err := parseDomainID(query.Get("source"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if query.Get("nonce") != "" {
+		nonce, err := strconv.ParseUint(query.Get("nonce"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid nonce %s", query.Get("nonce")))
+			return
+		}
+		e.handleDepositsByNonce(w, source, query.Get("destination"), nonce)
+		return
+	}
+
+	if query.Get("destination") == "" {
+		writeError(w, http.StatusBadRequest, errors.New("destination is required unless nonce is set"))
+		return
+	}
+	destination, err := parseDomainID(query.Get("destination"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var after uint64
+	if query.Get("after") != "" {
+		after, err = strconv.ParseUint(query.Get("after"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid after %s", query.Get("after")))
+			return
+		}
+	}
+	limit := defaultDepositsLimit
+	if query.Get("limit") != "" {
+		limit, err = strconv.Atoi(query.Get("limit"))
+		if err != nil || limit < 1 || limit > maxDepositsLimit {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("limit has to be between 1 and %d", maxDepositsLimit))
+			return
+		}
+	}
+
+	deposits, err := e.deposits.Deposits(source, destination, after, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := make([]Transfer, len(deposits))
+	for i, d := range deposits {
+		resp[i] = newTransfer(d)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// handleDepositsByNonce returns deposit from source with nonce to destination
+// or deposits with nonce to all destinations if destination is empty
+func (e *Explorer) handleDepositsByNonce(w http.ResponseWriter, source uint8, destination string, nonce uint64) {
+	if destination == "" {
+		deposits, err := e.deposits.DepositsByNonce(source, nonce)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		resp := make([]Transfer, len(deposits))
+		for i, d := range deposits {
+			resp[i] = newTransfer(d)
+		}
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+
+	destinationID, err := parseDomainID(destination)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	d, err := e.deposits.GetDeposit(source, destinationID, nonce)
+	if errors.Is(err, store.ErrNotFound) {
+		writeJSON(w, http.StatusOK, []Transfer{})
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, []Transfer{newTransfer(d)})
+}
+
+// handleDepositsByTx serves /deposits/by-tx/{hash}
+func (e *Explorer) handleDepositsByTx(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	hash := strings.Trim(strings.TrimPrefix(r.URL.Path, "/deposits/by-tx/"), "/")
+	b, err := hexutil.Decode(hash)
+	if err != nil || len(b) != common.HashLength {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid transaction hash %s", hash))
+		return
+	}
+
+	deposits, err := e.deposits.DepositsByTxHash(common.BytesToHash(b))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if len(deposits) == 0 {
+		writeError(w, http.StatusNotFound, errNotFound)
+		return
+	}
+
+	resp := make([]Transfer, len(deposits))
+	for i, d := range deposits {
+		resp[i] = newTransfer(d)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// handleRoute serves /routes/{source}/{destination}/stats
+func (e *Explorer) handleRoute(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/routes/"), "/"), "/")
+	if len(parts) != 3 || parts[2] != "stats" {
+		writeError(w, http.StatusNotFound, errNotFound)
+		return
+	}
+	source, err := parseDomainID(parts[0])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	destination, err := parseDomainID(parts[1])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	stats, err := e.deposits.RouteStats(source, destination)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newRouteStats(source, destination, stats))
+}
+
+func parseDomainID(s string) (uint8, error) {
+	id, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid domain ID %s", s)
+	}
+	return uint8(id), nil
+}
@@ -0,0 +1,185 @@
+package api_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/api"
+	mock_api "github.com/ChainSafe/chainbridge-core/api/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExplorerTestSuite struct {
+	suite.Suite
+	explorer          *api.Explorer
+	mockDepositStorer *mock_api.MockDepositStorer
+	deposits          []*store.Deposit
+}
+
+func TestRunExplorerTestSuite(t *testing.T) {
+	suite.Run(t, new(ExplorerTestSuite))
+}
+
+func (s *ExplorerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockDepositStorer = mock_api.NewMockDepositStorer(gomockController)
+	s.explorer = api.NewExplorer("", s.mockDepositStorer)
+	voteTxHash := common.HexToHash("0x5")
+	s.deposits = []*store.Deposit{
+		{
+			Source: 1, Destination: 2, DepositNonce: 1, ResourceID: types.ResourceID{1}, Type: message.FungibleTransfer,
+			TxHash: common.HexToHash("0x1"), Amount: big.NewInt(100), Recipient: []byte{1},
+			Status: store.DepositStatusExecuted, VoteTxHash: &voteTxHash,
+		},
+		{
+			Source: 1, Destination: 2, DepositNonce: 2, ResourceID: types.ResourceID{1}, Type: message.FungibleTransfer,
+			TxHash: common.HexToHash("0x2"), Amount: big.NewInt(50), Status: store.DepositStatusDeposited,
+		},
+		{
+			Source: 1, Destination: 3, DepositNonce: 1, ResourceID: types.ResourceID{2}, Type: message.NonFungibleTransfer,
+			TxHash: common.HexToHash("0x3"), TokenID: big.NewInt(7), Status: store.DepositStatusDeposited,
+		},
+	}
+}
+
+func (s *ExplorerTestSuite) request(path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	s.explorer.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *ExplorerTestSuite) TestDeposits_MissingSource() {
+	rec := s.request("/deposits?nonce=1")
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ExplorerTestSuite) TestDeposits_MissingDestination() {
+	rec := s.request("/deposits?source=1")
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ExplorerTestSuite) TestDeposits_PagesRoute() {
+	s.mockDepositStorer.EXPECT().Deposits(uint8(1), uint8(2), uint64(0), 100).Return(s.deposits[:2], nil)
+	s.mockDepositStorer.EXPECT().Deposits(uint8(1), uint8(2), uint64(1), 1).Return(s.deposits[1:2], nil)
+
+	rec := s.request("/deposits?source=1&destination=2")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var transfers []api.Transfer
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &transfers))
+	s.Len(transfers, 2)
+	s.Equal("100", transfers[0].Amount)
+	s.Equal("0x01", transfers[0].Recipient)
+	s.Equal(store.DepositStatusExecuted, transfers[0].Status)
+	s.Equal(common.HexToHash("0x5").Hex(), transfers[0].VoteTxHash)
+
+	rec = s.request("/deposits?source=1&destination=2&after=1&limit=1")
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &transfers))
+	s.Len(transfers, 1)
+	s.Equal(uint64(2), transfers[0].DepositNonce)
+}
+
+func (s *ExplorerTestSuite) TestDeposits_InvalidLimit() {
+	rec := s.request("/deposits?source=1&destination=2&limit=1001")
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ExplorerTestSuite) TestDeposits_FiltersByNonce() {
+	s.mockDepositStorer.EXPECT().GetDeposit(uint8(1), uint8(3), uint64(1)).Return(s.deposits[2], nil)
+
+	rec := s.request("/deposits?source=1&destination=3&nonce=1")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var transfers []api.Transfer
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &transfers))
+	s.Len(transfers, 1)
+	s.Equal(uint8(3), transfers[0].Destination)
+	s.Equal("7", transfers[0].TokenID)
+}
+
+func (s *ExplorerTestSuite) TestDeposits_FiltersByNonceAcrossRoutes() {
+	s.mockDepositStorer.EXPECT().DepositsByNonce(uint8(1), uint64(1)).Return([]*store.Deposit{s.deposits[0], s.deposits[2]}, nil)
+
+	rec := s.request("/deposits?source=1&nonce=1")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var transfers []api.Transfer
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &transfers))
+	s.Len(transfers, 2)
+	s.Equal(uint8(2), transfers[0].Destination)
+	s.Equal(uint8(3), transfers[1].Destination)
+}
+
+func (s *ExplorerTestSuite) TestDeposits_NonceNotFound() {
+	s.mockDepositStorer.EXPECT().GetDeposit(uint8(1), uint8(3), uint64(2)).Return(nil, store.ErrNotFound)
+
+	rec := s.request("/deposits?source=1&destination=3&nonce=2")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var transfers []api.Transfer
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &transfers))
+	s.Len(transfers, 0)
+}
+
+func (s *ExplorerTestSuite) TestDepositsByTx() {
+	s.mockDepositStorer.EXPECT().DepositsByTxHash(common.HexToHash("0x2")).Return(s.deposits[1:2], nil)
+
+	rec := s.request("/deposits/by-tx/" + common.HexToHash("0x2").Hex())
+
+	s.Equal(http.StatusOK, rec.Code)
+	var transfers []api.Transfer
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &transfers))
+	s.Len(transfers, 1)
+	s.Equal(uint64(2), transfers[0].DepositNonce)
+}
+
+func (s *ExplorerTestSuite) TestDepositsByTx_NotFound() {
+	s.mockDepositStorer.EXPECT().DepositsByTxHash(common.HexToHash("0x4")).Return([]*store.Deposit{}, nil)
+
+	rec := s.request("/deposits/by-tx/" + common.HexToHash("0x4").Hex())
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *ExplorerTestSuite) TestDepositsByTx_InvalidHash() {
+	rec := s.request("/deposits/by-tx/0x1234")
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ExplorerTestSuite) TestRouteStats() {
+	s.mockDepositStorer.EXPECT().RouteStats(uint8(1), uint8(2)).Return(&store.RouteStats{
+		Total:    2,
+		Statuses: map[store.DepositStatus]int{store.DepositStatusExecuted: 1, store.DepositStatusDeposited: 1},
+		Amounts:  map[string]*big.Int{"0x0100000000000000000000000000000000000000000000000000000000000000": big.NewInt(150)},
+	}, nil)
+
+	rec := s.request("/routes/1/2/stats")
+
+	s.Equal(http.StatusOK, rec.Code)
+	var stats api.RouteStats
+	s.Nil(json.Unmarshal(rec.Body.Bytes(), &stats))
+	s.Equal(2, stats.Total)
+	s.Equal(map[store.DepositStatus]int{store.DepositStatusExecuted: 1, store.DepositStatusDeposited: 1}, stats.Statuses)
+	s.Equal(map[string]string{"0x0100000000000000000000000000000000000000000000000000000000000000": "150"}, stats.Amounts)
+}
+
+func (s *ExplorerTestSuite) TestRoute_UnknownPath() {
+	rec := s.request("/routes/1/2")
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
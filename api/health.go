@@ -0,0 +1,72 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type HealthChecker interface {
+	Healthy() error
+	Ready() error
+}
+
+// HealthServer serves liveness and readiness probes. It is served separately
+// from the admin API so probes don't need access to it.
+type HealthServer struct {
+	address string
+	health  HealthChecker
+}
+
+func NewHealthServer(address string, health HealthChecker) *HealthServer {
+	return &HealthServer{
+		address: address,
+		health:  health,
+	}
+}
+
+// Start serves the probes until ctx is done. Failure to serve is sent to sysErr.
+func (h *HealthServer) Start(ctx context.Context, sysErr chan<- error) {
+	server := &http.Server{
+		Addr:              h.address,
+		Handler:           h.Handler(),
+		ReadHeaderTimeout: time.Second * 10,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("Failed shutting down health server")
+		}
+	}()
+
+	log.Info().Msgf("Starting health server on %s", h.address)
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		sysErr <- fmt.Errorf("health server failed: %w", err)
+	}
+}
+
+func (h *HealthServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", h.handleHealth)
+	mux.HandleFunc("/readyz", h.handleReady)
+	return mux
+}
+
+func (h *HealthServer) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeStatus(w, h.health.Healthy())
+}
+
+func (h *HealthServer) handleReady(w http.ResponseWriter, r *http.Request) {
+	writeStatus(w, h.health.Ready())
+}
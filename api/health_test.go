@@ -0,0 +1,44 @@
+package api_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/api"
+	mock_api "github.com/ChainSafe/chainbridge-core/api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthServerTestSuite struct {
+	suite.Suite
+	healthServer      *api.HealthServer
+	mockHealthChecker *mock_api.MockHealthChecker
+}
+
+func TestRunHealthServerTestSuite(t *testing.T) {
+	suite.Run(t, new(HealthServerTestSuite))
+}
+
+func (s *HealthServerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockHealthChecker = mock_api.NewMockHealthChecker(gomockController)
+	s.healthServer = api.NewHealthServer("", s.mockHealthChecker)
+}
+
+func (s *HealthServerTestSuite) request(path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	s.healthServer.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *HealthServerTestSuite) TestReportsHealthCheckerStatus() {
+	s.mockHealthChecker.EXPECT().Healthy().Return(nil)
+	s.mockHealthChecker.EXPECT().Ready().Return(errors.New("not polling"))
+
+	s.Equal(http.StatusOK, s.request("/healthz").Code)
+	s.Equal(http.StatusServiceUnavailable, s.request("/readyz").Code)
+}
@@ -0,0 +1,337 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./api/api.go
+
+// Package mock_api is a generated GoMock package.
+package mock_api
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	monitored "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	pool "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/pool"
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	store "github.com/ChainSafe/chainbridge-core/store"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBlockStorer is a mock of BlockStorer interface.
+type MockBlockStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockStorerMockRecorder
+}
+
+// MockBlockStorerMockRecorder is the mock recorder for MockBlockStorer.
+type MockBlockStorerMockRecorder struct {
+	mock *MockBlockStorer
+}
+
+// NewMockBlockStorer creates a new mock instance.
+func NewMockBlockStorer(ctrl *gomock.Controller) *MockBlockStorer {
+	mock := &MockBlockStorer{ctrl: ctrl}
+	mock.recorder = &MockBlockStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockStorer) EXPECT() *MockBlockStorerMockRecorder {
+	return m.recorder
+}
+
+// GetLastStoredBlock mocks base method.
+func (m *MockBlockStorer) GetLastStoredBlock(domainID uint8) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastStoredBlock", domainID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastStoredBlock indicates an expected call of GetLastStoredBlock.
+func (mr *MockBlockStorerMockRecorder) GetLastStoredBlock(domainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastStoredBlock", reflect.TypeOf((*MockBlockStorer)(nil).GetLastStoredBlock), domainID)
+}
+
+// MockMessageOutbox is a mock of MessageOutbox interface.
+type MockMessageOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageOutboxMockRecorder
+}
+
+// MockMessageOutboxMockRecorder is the mock recorder for MockMessageOutbox.
+type MockMessageOutboxMockRecorder struct {
+	mock *MockMessageOutbox
+}
+
+// NewMockMessageOutbox creates a new mock instance.
+func NewMockMessageOutbox(ctrl *gomock.Controller) *MockMessageOutbox {
+	mock := &MockMessageOutbox{ctrl: ctrl}
+	mock.recorder = &MockMessageOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageOutbox) EXPECT() *MockMessageOutboxMockRecorder {
+	return m.recorder
+}
+
+// MarkDone mocks base method.
+func (m_2 *MockMessageOutbox) MarkDone(m *message.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "MarkDone", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDone indicates an expected call of MarkDone.
+func (mr *MockMessageOutboxMockRecorder) MarkDone(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDone", reflect.TypeOf((*MockMessageOutbox)(nil).MarkDone), m)
+}
+
+// PendingMessages mocks base method.
+func (m *MockMessageOutbox) PendingMessages(source uint8) ([]*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingMessages", source)
+	ret0, _ := ret[0].([]*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingMessages indicates an expected call of PendingMessages.
+func (mr *MockMessageOutboxMockRecorder) PendingMessages(source interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingMessages", reflect.TypeOf((*MockMessageOutbox)(nil).PendingMessages), source)
+}
+
+// StoreMessages mocks base method.
+func (m *MockMessageOutbox) StoreMessages(block *big.Int, msgs []*message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreMessages", block, msgs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreMessages indicates an expected call of StoreMessages.
+func (mr *MockMessageOutboxMockRecorder) StoreMessages(block, msgs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreMessages", reflect.TypeOf((*MockMessageOutbox)(nil).StoreMessages), block, msgs)
+}
+
+// MockDeadLetterStorer is a mock of DeadLetterStorer interface.
+type MockDeadLetterStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterStorerMockRecorder
+}
+
+// MockDeadLetterStorerMockRecorder is the mock recorder for MockDeadLetterStorer.
+type MockDeadLetterStorerMockRecorder struct {
+	mock *MockDeadLetterStorer
+}
+
+// NewMockDeadLetterStorer creates a new mock instance.
+func NewMockDeadLetterStorer(ctrl *gomock.Controller) *MockDeadLetterStorer {
+	mock := &MockDeadLetterStorer{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterStorer) EXPECT() *MockDeadLetterStorerMockRecorder {
+	return m.recorder
+}
+
+// DeadLetters mocks base method.
+func (m *MockDeadLetterStorer) DeadLetters(destination uint8) ([]*store.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetters", destination)
+	ret0, _ := ret[0].([]*store.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetters indicates an expected call of DeadLetters.
+func (mr *MockDeadLetterStorerMockRecorder) DeadLetters(destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetters", reflect.TypeOf((*MockDeadLetterStorer)(nil).DeadLetters), destination)
+}
+
+// DeleteDeadLetter mocks base method.
+func (m_2 *MockDeadLetterStorer) DeleteDeadLetter(m *message.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "DeleteDeadLetter", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeadLetter indicates an expected call of DeleteDeadLetter.
+func (mr *MockDeadLetterStorerMockRecorder) DeleteDeadLetter(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadLetter", reflect.TypeOf((*MockDeadLetterStorer)(nil).DeleteDeadLetter), m)
+}
+
+// StoreDeadLetter mocks base method.
+func (m_2 *MockDeadLetterStorer) StoreDeadLetter(m *message.Message, err error, attempts int) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "StoreDeadLetter", m, err, attempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreDeadLetter indicates an expected call of StoreDeadLetter.
+func (mr *MockDeadLetterStorerMockRecorder) StoreDeadLetter(m, err, attempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreDeadLetter", reflect.TypeOf((*MockDeadLetterStorer)(nil).StoreDeadLetter), m, err, attempts)
+}
+
+// MockMessageSubmitter is a mock of MessageSubmitter interface.
+type MockMessageSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageSubmitterMockRecorder
+}
+
+// MockMessageSubmitterMockRecorder is the mock recorder for MockMessageSubmitter.
+type MockMessageSubmitterMockRecorder struct {
+	mock *MockMessageSubmitter
+}
+
+// NewMockMessageSubmitter creates a new mock instance.
+func NewMockMessageSubmitter(ctrl *gomock.Controller) *MockMessageSubmitter {
+	mock := &MockMessageSubmitter{ctrl: ctrl}
+	mock.recorder = &MockMessageSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageSubmitter) EXPECT() *MockMessageSubmitterMockRecorder {
+	return m.recorder
+}
+
+// Submit mocks base method.
+func (m *MockMessageSubmitter) Submit(ctx context.Context, msgs []*message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, msgs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockMessageSubmitterMockRecorder) Submit(ctx, msgs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockMessageSubmitter)(nil).Submit), ctx, msgs)
+}
+
+// MockTransactionMonitor is a mock of TransactionMonitor interface.
+type MockTransactionMonitor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionMonitorMockRecorder
+}
+
+// MockTransactionMonitorMockRecorder is the mock recorder for MockTransactionMonitor.
+type MockTransactionMonitorMockRecorder struct {
+	mock *MockTransactionMonitor
+}
+
+// NewMockTransactionMonitor creates a new mock instance.
+func NewMockTransactionMonitor(ctrl *gomock.Controller) *MockTransactionMonitor {
+	mock := &MockTransactionMonitor{ctrl: ctrl}
+	mock.recorder = &MockTransactionMonitorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionMonitor) EXPECT() *MockTransactionMonitorMockRecorder {
+	return m.recorder
+}
+
+// PendingTransactions mocks base method.
+func (m *MockTransactionMonitor) PendingTransactions() []monitored.PendingTransaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingTransactions")
+	ret0, _ := ret[0].([]monitored.PendingTransaction)
+	return ret0
+}
+
+// PendingTransactions indicates an expected call of PendingTransactions.
+func (mr *MockTransactionMonitorMockRecorder) PendingTransactions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTransactions", reflect.TypeOf((*MockTransactionMonitor)(nil).PendingTransactions))
+}
+
+// MockKeyMonitor is a mock of KeyMonitor interface.
+type MockKeyMonitor struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyMonitorMockRecorder
+}
+
+// MockKeyMonitorMockRecorder is the mock recorder for MockKeyMonitor.
+type MockKeyMonitorMockRecorder struct {
+	mock *MockKeyMonitor
+}
+
+// NewMockKeyMonitor creates a new mock instance.
+func NewMockKeyMonitor(ctrl *gomock.Controller) *MockKeyMonitor {
+	mock := &MockKeyMonitor{ctrl: ctrl}
+	mock.recorder = &MockKeyMonitorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyMonitor) EXPECT() *MockKeyMonitorMockRecorder {
+	return m.recorder
+}
+
+// Keys mocks base method.
+func (m *MockKeyMonitor) Keys(ctx context.Context) ([]pool.KeyStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys", ctx)
+	ret0, _ := ret[0].([]pool.KeyStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockKeyMonitorMockRecorder) Keys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockKeyMonitor)(nil).Keys), ctx)
+}
+
+// MockDepositFinder is a mock of DepositFinder interface.
+type MockDepositFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositFinderMockRecorder
+}
+
+// MockDepositFinderMockRecorder is the mock recorder for MockDepositFinder.
+type MockDepositFinderMockRecorder struct {
+	mock *MockDepositFinder
+}
+
+// NewMockDepositFinder creates a new mock instance.
+func NewMockDepositFinder(ctrl *gomock.Controller) *MockDepositFinder {
+	mock := &MockDepositFinder{ctrl: ctrl}
+	mock.recorder = &MockDepositFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositFinder) EXPECT() *MockDepositFinderMockRecorder {
+	return m.recorder
+}
+
+// FindDeposit mocks base method.
+func (m *MockDepositFinder) FindDeposit(ctx context.Context, destination uint8, depositNonce uint64) (*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeposit", ctx, destination, depositNonce)
+	ret0, _ := ret[0].(*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeposit indicates an expected call of FindDeposit.
+func (mr *MockDepositFinderMockRecorder) FindDeposit(ctx, destination, depositNonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeposit", reflect.TypeOf((*MockDepositFinder)(nil).FindDeposit), ctx, destination, depositNonce)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./api/explorer.go
+
+// Package mock_api is a generated GoMock package.
+package mock_api
+
+import (
+	reflect "reflect"
+
+	store "github.com/ChainSafe/chainbridge-core/store"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDepositStorer is a mock of DepositStorer interface.
+type MockDepositStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositStorerMockRecorder
+}
+
+// MockDepositStorerMockRecorder is the mock recorder for MockDepositStorer.
+type MockDepositStorerMockRecorder struct {
+	mock *MockDepositStorer
+}
+
+// NewMockDepositStorer creates a new mock instance.
+func NewMockDepositStorer(ctrl *gomock.Controller) *MockDepositStorer {
+	mock := &MockDepositStorer{ctrl: ctrl}
+	mock.recorder = &MockDepositStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositStorer) EXPECT() *MockDepositStorerMockRecorder {
+	return m.recorder
+}
+
+// Deposits mocks base method.
+func (m *MockDepositStorer) Deposits(source, destination uint8, after uint64, limit int) ([]*store.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposits", source, destination, after, limit)
+	ret0, _ := ret[0].([]*store.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposits indicates an expected call of Deposits.
+func (mr *MockDepositStorerMockRecorder) Deposits(source, destination, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposits", reflect.TypeOf((*MockDepositStorer)(nil).Deposits), source, destination, after, limit)
+}
+
+// DepositsByNonce mocks base method.
+func (m *MockDepositStorer) DepositsByNonce(source uint8, depositNonce uint64) ([]*store.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DepositsByNonce", source, depositNonce)
+	ret0, _ := ret[0].([]*store.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DepositsByNonce indicates an expected call of DepositsByNonce.
+func (mr *MockDepositStorerMockRecorder) DepositsByNonce(source, depositNonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositsByNonce", reflect.TypeOf((*MockDepositStorer)(nil).DepositsByNonce), source, depositNonce)
+}
+
+// DepositsByTxHash mocks base method.
+func (m *MockDepositStorer) DepositsByTxHash(txHash common.Hash) ([]*store.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DepositsByTxHash", txHash)
+	ret0, _ := ret[0].([]*store.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DepositsByTxHash indicates an expected call of DepositsByTxHash.
+func (mr *MockDepositStorerMockRecorder) DepositsByTxHash(txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositsByTxHash", reflect.TypeOf((*MockDepositStorer)(nil).DepositsByTxHash), txHash)
+}
+
+// GetDeposit mocks base method.
+func (m *MockDepositStorer) GetDeposit(source, destination uint8, depositNonce uint64) (*store.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeposit", source, destination, depositNonce)
+	ret0, _ := ret[0].(*store.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeposit indicates an expected call of GetDeposit.
+func (mr *MockDepositStorerMockRecorder) GetDeposit(source, destination, depositNonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeposit", reflect.TypeOf((*MockDepositStorer)(nil).GetDeposit), source, destination, depositNonce)
+}
+
+// RouteStats mocks base method.
+func (m *MockDepositStorer) RouteStats(source, destination uint8) (*store.RouteStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RouteStats", source, destination)
+	ret0, _ := ret[0].(*store.RouteStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RouteStats indicates an expected call of RouteStats.
+func (mr *MockDepositStorerMockRecorder) RouteStats(source, destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouteStats", reflect.TypeOf((*MockDepositStorer)(nil).RouteStats), source, destination)
+}
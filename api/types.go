@@ -0,0 +1,212 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package api
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/pool"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Domain is the listener position of a domain
+type Domain struct {
+	DomainID  uint8  `json:"domainId"`
+	LastBlock string `json:"lastBlock"`
+}
+
+// MessageID identifies a message by its source, destination and deposit nonce
+type MessageID struct {
+	Source       uint8  `json:"source"`
+	Destination  uint8  `json:"destination"`
+	DepositNonce uint64 `json:"depositNonce"`
+}
+
+// Message is a JSON representation of a message with hex encoded payload
+type Message struct {
+	Source       uint8                `json:"source"`
+	Destination  uint8                `json:"destination"`
+	DepositNonce uint64               `json:"depositNonce"`
+	ResourceID   string               `json:"resourceId"`
+	Type         message.TransferType `json:"type"`
+	Payload      []string             `json:"payload"`
+}
+
+// FailedMessage is a dead lettered message with its last execution error
+type FailedMessage struct {
+	Message
+	Error    string    `json:"error"`
+	Attempts int       `json:"attempts"`
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// Transaction is a monitored transaction waiting to be included on chain
+type Transaction struct {
+	Hash          string    `json:"hash"`
+	From          string    `json:"from"`
+	Nonce         uint64    `json:"nonce"`
+	To            string    `json:"to,omitempty"`
+	GasPrice      []string  `json:"gasPrice"`
+	SubmitTime    time.Time `json:"submitTime"`
+	CreationTime  time.Time `json:"creationTime"`
+	Cancelled     bool      `json:"cancelled,omitempty"`
+	GasCapReached bool      `json:"gasCapReached,omitempty"`
+}
+
+// Key is a signing key of a domain with its balance and number of pending transactions
+type Key struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+	Pending int    `json:"pending"`
+}
+
+// Transfer is a deposit read from the source domain with its status on the destination domain
+type Transfer struct {
+	Source       uint8                `json:"source"`
+	Destination  uint8                `json:"destination"`
+	DepositNonce uint64               `json:"depositNonce"`
+	ResourceID   string               `json:"resourceId"`
+	Type         message.TransferType `json:"type"`
+	TxHash       string               `json:"txHash"`
+	BlockNumber  uint64               `json:"blockNumber"`
+	Sender       string               `json:"sender"`
+	Recipient    string               `json:"recipient,omitempty"`
+	Amount       string               `json:"amount,omitempty"`
+	TokenID      string               `json:"tokenId,omitempty"`
+	Status       store.DepositStatus  `json:"status"`
+	VoteTxHash   string               `json:"voteTxHash,omitempty"`
+	UpdatedAt    time.Time            `json:"updatedAt"`
+}
+
+// RouteStats are deposit counts by status and fungible amounts by resource of a route
+type RouteStats struct {
+	Source      uint8                       `json:"source"`
+	Destination uint8                       `json:"destination"`
+	Total       int                         `json:"total"`
+	Statuses    map[store.DepositStatus]int `json:"statuses"`
+	Amounts     map[string]string           `json:"amounts"`
+}
+
+type statusResponse struct {
+	Status string `json:"status"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func newMessage(m *message.Message) Message {
+	payload := make([]string, len(m.Payload))
+	for i, p := range m.Payload {
+		if b, ok := p.([]byte); ok {
+			payload[i] = hexutil.Encode(b)
+		} else {
+			payload[i] = fmt.Sprint(p)
+		}
+	}
+
+	return Message{
+		Source:       m.Source,
+		Destination:  m.Destination,
+		DepositNonce: m.DepositNonce,
+		ResourceID:   hexutil.Encode(m.ResourceId[:]),
+		Type:         m.Type,
+		Payload:      payload,
+	}
+}
+
+func newFailedMessage(dl *store.DeadLetter) FailedMessage {
+	return FailedMessage{
+		Message:  newMessage(dl.Message),
+		Error:    dl.Error,
+		Attempts: dl.Attempts,
+		FailedAt: dl.FailedAt,
+	}
+}
+
+func newTransaction(tx monitored.PendingTransaction) Transaction {
+	gasPrice := make([]string, len(tx.GasPrice))
+	for i, gp := range tx.GasPrice {
+		gasPrice[i] = gp.String()
+	}
+
+	to := ""
+	if tx.To != nil {
+		to = tx.To.Hex()
+	}
+
+	return Transaction{
+		Hash:          tx.Hash.Hex(),
+		From:          tx.From.Hex(),
+		Nonce:         tx.Nonce,
+		To:            to,
+		GasPrice:      gasPrice,
+		SubmitTime:    tx.SubmitTime,
+		CreationTime:  tx.CreationTime,
+		Cancelled:     tx.Cancelled,
+		GasCapReached: tx.GasCapReached,
+	}
+}
+
+func newKey(status pool.KeyStatus) Key {
+	return Key{
+		Address: status.Address.Hex(),
+		Balance: status.Balance.String(),
+		Pending: status.Pending,
+	}
+}
+
+func newDomain(domainID uint8, lastBlock *big.Int) Domain {
+	return Domain{
+		DomainID:  domainID,
+		LastBlock: lastBlock.String(),
+	}
+}
+
+func newTransfer(d *store.Deposit) Transfer {
+	t := Transfer{
+		Source:       d.Source,
+		Destination:  d.Destination,
+		DepositNonce: d.DepositNonce,
+		ResourceID:   hexutil.Encode(d.ResourceID[:]),
+		Type:         d.Type,
+		TxHash:       d.TxHash.Hex(),
+		BlockNumber:  d.BlockNumber,
+		Sender:       d.Sender.Hex(),
+		Status:       d.Status,
+		UpdatedAt:    d.UpdatedAt,
+	}
+	if d.Recipient != nil {
+		t.Recipient = hexutil.Encode(d.Recipient)
+	}
+	if d.Amount != nil {
+		t.Amount = d.Amount.String()
+	}
+	if d.TokenID != nil {
+		t.TokenID = d.TokenID.String()
+	}
+	if d.VoteTxHash != nil {
+		t.VoteTxHash = d.VoteTxHash.Hex()
+	}
+	return t
+}
+
+func newRouteStats(source uint8, destination uint8, routeStats *store.RouteStats) RouteStats {
+	stats := RouteStats{
+		Source:      source,
+		Destination: destination,
+		Total:       routeStats.Total,
+		Statuses:    routeStats.Statuses,
+		Amounts:     make(map[string]string, len(routeStats.Amounts)),
+	}
+	for resourceID, amount := range routeStats.Amounts {
+		stats.Amounts[resourceID] = amount.String()
+	}
+	return stats
+}
@@ -290,6 +290,17 @@ func (c *BridgeContract) GetThreshold() (uint8, error) {
 	return out, nil
 }
 
+// DepositCount returns nonce of the last deposit made to destination domain
+func (c *BridgeContract) DepositCount(destinationDomainID uint8) (uint64, error) {
+	log.Debug().Msgf("Getting deposit count of destination %d", destinationDomainID)
+	res, err := c.CallContract("_depositCounts", destinationDomainID)
+	if err != nil {
+		return 0, err
+	}
+	out := *abi.ConvertType(res[0], new(uint64)).(*uint64)
+	return out, nil
+}
+
 func (c *BridgeContract) IsRelayer(relayerAddress common.Address) (bool, error) {
 	log.Debug().Msgf("Getting is %s a relayer", relayerAddress.String())
 	res, err := c.CallContract("isRelayer", relayerAddress)
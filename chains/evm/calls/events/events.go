@@ -1,6 +1,8 @@
 package events
 
 import (
+	"math/big"
+
 	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -17,6 +19,8 @@ const (
 	ThresholdChangedSig EventSig = "RelayerThresholdChanged(uint256)"
 	ProposalEventSig    EventSig = "ProposalEvent(uint8,uint64,uint8,bytes32)"
 	ProposalVoteSig     EventSig = "ProposalVote(uint8,uint64,uint8,bytes32)"
+	RelayerAddedSig     EventSig = "RelayerAdded(address)"
+	RelayerRemovedSig   EventSig = "RelayerRemoved(address)"
 )
 
 // Deposit struct holds event data with all necessary parameters and a handler response
@@ -30,6 +34,10 @@ type Deposit struct {
 	DepositNonce uint64
 	// Address of sender (msg.sender: user)
 	SenderAddress common.Address
+	// Hash of the deposit transaction
+	TxHash common.Hash
+	// Block the deposit was included in
+	BlockNumber uint64
 	// Additional data to be passed to specified handler
 	Data []byte
 	// ERC20Handler: responds with empty data
@@ -37,3 +45,39 @@ type Deposit struct {
 	// GenericHandler: responds with the raw bytes returned from the call to the target contract
 	HandlerResponse []byte
 }
+
+// ProposalEvent is emitted by the destination bridge when proposal status changes
+type ProposalEvent struct {
+	// ID of chain deposit was made on
+	OriginDomainID uint8
+	// Nonce of deposit
+	DepositNonce uint64
+	// New proposal status
+	Status uint8
+	// Hash of handler address and deposit data
+	DataHash [32]byte
+}
+
+// ProposalVote is emitted by the destination bridge when a relayer votes on a proposal
+type ProposalVote struct {
+	// ID of chain deposit was made on
+	OriginDomainID uint8
+	// Nonce of deposit
+	DepositNonce uint64
+	// Proposal status after the vote
+	Status uint8
+	// Hash of handler address and deposit data
+	DataHash [32]byte
+}
+
+// ThresholdChanged is emitted by the bridge when relayer vote threshold is changed
+type ThresholdChanged struct {
+	// Number of votes required for proposal to pass
+	NewThreshold *big.Int
+}
+
+// RelayerChanged is emitted by the bridge when relayer is added or removed
+type RelayerChanged struct {
+	// Address of the added or removed relayer
+	Relayer common.Address
+}
@@ -44,6 +44,8 @@ func (l *Listener) FetchDeposits(ctx context.Context, contractAddress common.Add
 		}
 
 		d.SenderAddress = common.BytesToAddress(dl.Topics[1].Bytes())
+		d.TxHash = dl.TxHash
+		d.BlockNumber = dl.BlockNumber
 		log.Debug().Msgf("Found deposit log in block: %d, TxHash: %s, contractAddress: %s, sender: %s", dl.BlockNumber, dl.TxHash, dl.Address, d.SenderAddress)
 
 		deposits = append(deposits, d)
@@ -62,3 +64,118 @@ func (l *Listener) UnpackDeposit(abi abi.ABI, data []byte) (*Deposit, error) {
 
 	return &dl, nil
 }
+
+func (l *Listener) FetchProposalEvents(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) ([]*ProposalEvent, error) {
+	logs, err := l.client.FetchEventLogs(ctx, contractAddress, string(ProposalEventSig), startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+	proposalEvents := make([]*ProposalEvent, 0)
+
+	for _, pl := range logs {
+		e, err := l.UnpackProposalEvent(l.abi, pl.Data)
+		if err != nil {
+			log.Error().Msgf("failed unpacking proposal event log: %v", err)
+			continue
+		}
+
+		log.Debug().Msgf("Found proposal event log in block: %d, TxHash: %s, contractAddress: %s", pl.BlockNumber, pl.TxHash, pl.Address)
+		proposalEvents = append(proposalEvents, e)
+	}
+
+	return proposalEvents, nil
+}
+
+func (l *Listener) UnpackProposalEvent(abi abi.ABI, data []byte) (*ProposalEvent, error) {
+	var pe ProposalEvent
+
+	err := abi.UnpackIntoInterface(&pe, "ProposalEvent", data)
+	if err != nil {
+		return &ProposalEvent{}, err
+	}
+
+	return &pe, nil
+}
+
+func (l *Listener) FetchProposalVotes(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) ([]*ProposalVote, error) {
+	logs, err := l.client.FetchEventLogs(ctx, contractAddress, string(ProposalVoteSig), startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+	votes := make([]*ProposalVote, 0)
+
+	for _, vl := range logs {
+		v, err := l.UnpackProposalVote(l.abi, vl.Data)
+		if err != nil {
+			log.Error().Msgf("failed unpacking proposal vote log: %v", err)
+			continue
+		}
+
+		log.Debug().Msgf("Found proposal vote log in block: %d, TxHash: %s, contractAddress: %s", vl.BlockNumber, vl.TxHash, vl.Address)
+		votes = append(votes, v)
+	}
+
+	return votes, nil
+}
+
+func (l *Listener) UnpackProposalVote(abi abi.ABI, data []byte) (*ProposalVote, error) {
+	var pv ProposalVote
+
+	err := abi.UnpackIntoInterface(&pv, "ProposalVote", data)
+	if err != nil {
+		return &ProposalVote{}, err
+	}
+
+	return &pv, nil
+}
+
+func (l *Listener) FetchThresholdChanges(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) ([]*ThresholdChanged, error) {
+	logs, err := l.client.FetchEventLogs(ctx, contractAddress, string(ThresholdChangedSig), startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*ThresholdChanged, 0)
+
+	for _, tl := range logs {
+		var tc ThresholdChanged
+		err := l.abi.UnpackIntoInterface(&tc, "RelayerThresholdChanged", tl.Data)
+		if err != nil {
+			log.Error().Msgf("failed unpacking threshold changed log: %v", err)
+			continue
+		}
+
+		log.Debug().Msgf("Found threshold changed log in block: %d, TxHash: %s, contractAddress: %s", tl.BlockNumber, tl.TxHash, tl.Address)
+		changes = append(changes, &tc)
+	}
+
+	return changes, nil
+}
+
+// FetchRelayerChanges returns relayers added to or removed from the bridge in the block range
+func (l *Listener) FetchRelayerChanges(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) ([]*RelayerChanged, error) {
+	changes := make([]*RelayerChanged, 0)
+	relayerEvents := []struct {
+		sig  EventSig
+		name string
+	}{{RelayerAddedSig, "RelayerAdded"}, {RelayerRemovedSig, "RelayerRemoved"}}
+	for _, event := range relayerEvents {
+		logs, err := l.client.FetchEventLogs(ctx, contractAddress, string(event.sig), startBlock, endBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rl := range logs {
+			var rc RelayerChanged
+			err := l.abi.UnpackIntoInterface(&rc, event.name, rl.Data)
+			if err != nil {
+				log.Error().Msgf("failed unpacking relayer changed log: %v", err)
+				continue
+			}
+
+			log.Debug().Msgf("Found relayer changed log in block: %d, TxHash: %s, contractAddress: %s", rl.BlockNumber, rl.TxHash, rl.Address)
+			changes = append(changes, &rc)
+		}
+	}
+
+	return changes, nil
+}
@@ -50,3 +50,35 @@ func (s *EvmClientTestSuite) TestUnpackDepositEventLogValidData() {
 	s.Equal(dl.ResourceID, expectedRID)
 	s.Equal(dl.HandlerResponse, []byte{})
 }
+
+func (s *EvmClientTestSuite) TestUnpackProposalEventFailedUnpack() {
+	abi, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	_, err := s.listener.UnpackProposalEvent(abi, []byte("invalid"))
+	s.NotNil(err)
+}
+
+func (s *EvmClientTestSuite) TestUnpackProposalEventValidData() {
+	abi, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	data, _ := abi.Events["ProposalEvent"].Inputs.Pack(uint8(1), uint64(5), uint8(3), [32]byte{1})
+	pe, err := s.listener.UnpackProposalEvent(abi, data)
+	s.Nil(err)
+	s.Equal(pe, &events.ProposalEvent{
+		OriginDomainID: 1,
+		DepositNonce:   5,
+		Status:         3,
+		DataHash:       [32]byte{1},
+	})
+}
+
+func (s *EvmClientTestSuite) TestUnpackProposalVoteValidData() {
+	abi, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	data, _ := abi.Events["ProposalVote"].Inputs.Pack(uint8(2), uint64(7), uint8(1), [32]byte{2})
+	pv, err := s.listener.UnpackProposalVote(abi, data)
+	s.Nil(err)
+	s.Equal(pv, &events.ProposalVote{
+		OriginDomainID: 2,
+		DepositNonce:   7,
+		Status:         1,
+		DataHash:       [32]byte{2},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -17,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
 )
 
 type EVMClient struct {
@@ -26,6 +28,10 @@ type EVMClient struct {
 	rpClient   *rpc.Client
 	nonce      *big.Int
 	nonceLock  sync.Mutex
+	// resyncNonce is set when the node rejected a transaction because of its nonce
+	resyncNonce atomic.Bool
+	// nonceGaps are nonces missing from the node transaction pool by the time they were detected
+	nonceGaps map[uint64]time.Time
 }
 
 type Signer interface {
@@ -50,7 +56,9 @@ func NewEVMClient(url string, signer Signer) (*EVMClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	c := &EVMClient{}
+	c := &EVMClient{
+		nonceGaps: make(map[uint64]time.Time),
+	}
 	c.Client = ethclient.NewClient(rpcClient)
 	c.gethClient = gethclient.New(rpcClient)
 	c.rpClient = rpcClient
@@ -94,6 +102,36 @@ func (h *headerNumber) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// TaggedBlock returns number of the block with provided tag, e.g. "finalized" or "safe"
+func (c *EVMClient) TaggedBlock(tag string) (*big.Int, error) {
+	var head *headerNumber
+	err := c.rpClient.CallContext(context.Background(), &head, "eth_getBlockByNumber", tag, false)
+	if err == nil && head == nil {
+		err = ethereum.NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return head.Number, nil
+}
+
+// BlockHash returns hash of the canonical block with provided number
+func (c *EVMClient) BlockHash(number *big.Int) (common.Hash, error) {
+	var head *headerHash
+	err := c.rpClient.CallContext(context.Background(), &head, "eth_getBlockByNumber", toBlockNumArg(number), false)
+	if err == nil && head == nil {
+		err = ethereum.NotFound
+	}
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return head.Hash, nil
+}
+
+type headerHash struct {
+	Hash common.Hash `json:"hash"`
+}
+
 func (c *EVMClient) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
 	retry := 50
 	for retry > 0 {
@@ -180,6 +218,13 @@ func (c *EVMClient) SignAndSendTransaction(ctx context.Context, tx CommonTransac
 	}
 	err = c.SendRawTransaction(ctx, rawTx)
 	if err != nil {
+		switch ClassifySendError(err) {
+		case SendErrorAlreadyKnown:
+			return tx.Hash(), nil
+		case SendErrorNonceTooLow, SendErrorNonceTooHigh:
+			log.Warn().Err(err).Msgf("Transaction %s rejected because of its nonce, resyncing nonce", tx.Hash())
+			c.resyncNonce.Store(true)
+		}
 		return common.Hash{}, err
 	}
 	return tx.Hash(), nil
@@ -197,17 +242,17 @@ func (c *EVMClient) UnlockNonce() {
 	c.nonceLock.Unlock()
 }
 
+// UnsafeNonce returns local nonce. Nonce is synced with the node on first
+// use and after the node rejected a transaction because of its nonce.
 func (c *EVMClient) UnsafeNonce() (*big.Int, error) {
 	var err error
 	for i := 0; i <= 10; i++ {
-		if c.nonce == nil {
-			nonce, err := c.PendingNonceAt(context.Background(), c.signer.CommonAddress())
+		if c.nonce == nil || c.resyncNonce.Load() {
+			err = c.unsafeResyncNonce(context.Background())
 			if err != nil {
 				time.Sleep(1 * time.Second)
 				continue
 			}
-			c.nonce = big.NewInt(0).SetUint64(nonce)
-			return c.nonce, nil
 		}
 		return c.nonce, nil
 	}
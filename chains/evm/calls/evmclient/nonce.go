@@ -0,0 +1,191 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package evmclient
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// SendError classifies errors returned by the node when sending a transaction
+type SendError int
+
+const (
+	SendErrorUnknown SendError = iota
+	// SendErrorNonceTooLow is returned when the nonce was already used by a mined transaction
+	SendErrorNonceTooLow
+	// SendErrorNonceTooHigh is returned when there is a gap between the nonce and the account nonce
+	SendErrorNonceTooHigh
+	// SendErrorAlreadyKnown is returned when the same transaction is already in the pool
+	SendErrorAlreadyKnown
+	// SendErrorUnderpriced is returned when the replacement or the transaction gas price is too low
+	SendErrorUnderpriced
+	SendErrorInsufficientFunds
+)
+
+var sendErrors = []struct {
+	messages []string
+	kind     SendError
+}{
+	{[]string{"nonce too low", "nonce is too low", "oldnonce"}, SendErrorNonceTooLow},
+	{[]string{"nonce too high", "nonce gap", "nonce has max value"}, SendErrorNonceTooHigh},
+	{[]string{"already known", "known transaction", "already imported"}, SendErrorAlreadyKnown},
+	{[]string{"underpriced", "fee too low"}, SendErrorUnderpriced},
+	{[]string{"insufficient funds"}, SendErrorInsufficientFunds},
+}
+
+// ClassifySendError maps error messages of different node implementations to a SendError
+func ClassifySendError(err error) SendError {
+	if err == nil {
+		return SendErrorUnknown
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, e := range sendErrors {
+		for _, m := range e.messages {
+			if strings.Contains(msg, m) {
+				return e.kind
+			}
+		}
+	}
+	return SendErrorUnknown
+}
+
+// GapTxFabric creates transactions used to fill nonce gaps
+type GapTxFabric func(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (CommonTransaction, error)
+
+// TxMonitor is the transactor that resends transactions of the client until
+// they are included
+type TxMonitor interface {
+	// MonitorsNonce reports whether transaction with nonce is still resent by the monitor
+	MonitorsNonce(nonce uint64) bool
+	// IncreaseGas returns gas prices of a replacement transaction
+	IncreaseGas(oldGp []*big.Int) ([]*big.Int, error)
+}
+
+const selfTransferGasLimit = 21000
+
+// MonitorNonce periodically resyncs local nonce with the node and fills nonces
+// that are missing from the node transaction pool for longer than gapTimeout
+// with no-op self transfers, so transactions with later nonces can be mined.
+//
+// Nonces of transactions that monitor still resends are left to the monitor.
+// Fills are priced as monitor replacements, in case a transaction with the
+// nonce is still known to some nodes.
+func (c *EVMClient) MonitorNonce(ctx context.Context, txFabric GapTxFabric, monitor TxMonitor, interval time.Duration, gapTimeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := c.fillNonceGaps(ctx, txFabric, monitor, gapTimeout)
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed checking nonce gaps")
+			}
+		}
+	}
+}
+
+// fillNonceGaps fills lost nonces and returns the last error if filling
+// any of them failed
+func (c *EVMClient) fillNonceGaps(ctx context.Context, txFabric GapTxFabric, monitor TxMonitor, gapTimeout time.Duration) error {
+	c.LockNonce()
+	defer c.UnlockNonce()
+
+	err := c.unsafeResyncNonce(ctx)
+	if err != nil {
+		return err
+	}
+
+	lost := make([]uint64, 0)
+	for nonce, detected := range c.nonceGaps {
+		if time.Since(detected) <= gapTimeout {
+			continue
+		}
+		if monitor.MonitorsNonce(nonce) {
+			log.Debug().Uint64("nonce", nonce).Msgf("Nonce gap at %d is still monitored, not filling it", nonce)
+			continue
+		}
+		lost = append(lost, nonce)
+	}
+	if len(lost) == 0 {
+		return nil
+	}
+	sort.Slice(lost, func(i, j int) bool { return lost[i] < lost[j] })
+
+	gasPrice, err := c.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	gasPrices, err := monitor.IncreaseGas([]*big.Int{gasPrice})
+	if err != nil {
+		return err
+	}
+	from := c.From()
+	var fillErr error
+	for _, nonce := range lost {
+		tx, err := txFabric(nonce, &from, big.NewInt(0), selfTransferGasLimit, gasPrices, nil)
+		if err != nil {
+			log.Warn().Err(err).Uint64("nonce", nonce).Msgf("Failed creating self transfer for lost nonce %d", nonce)
+			fillErr = err
+			continue
+		}
+
+		hash, err := c.SignAndSendTransaction(ctx, tx)
+		if err != nil {
+			log.Warn().Err(err).Uint64("nonce", nonce).Msgf("Failed filling lost nonce %d", nonce)
+			fillErr = err
+			continue
+		}
+		log.Warn().Uint64("nonce", nonce).Msgf("Filled lost nonce %d with self transfer %s", nonce, hash)
+		delete(c.nonceGaps, nonce)
+	}
+	return fillErr
+}
+
+// unsafeResyncNonce moves local nonce forward if nonces were used outside
+// of the client and records nonces that are missing from the node
+// transaction pool. Nonce lock has to be held by the caller.
+func (c *EVMClient) unsafeResyncNonce(ctx context.Context) error {
+	pending, err := c.PendingNonceAt(ctx, c.From())
+	if err != nil {
+		return err
+	}
+	mined, err := c.NonceAt(ctx, c.From(), nil)
+	if err != nil {
+		return err
+	}
+	c.resyncNonce.Store(false)
+
+	if c.nonce == nil || c.nonce.Uint64() < pending {
+		if c.nonce != nil {
+			log.Warn().Msgf("Local nonce %d behind pending nonce %d, resyncing", c.nonce.Uint64(), pending)
+		}
+		c.nonce = new(big.Int).SetUint64(pending)
+	}
+
+	// nonces between the pending and the local nonce were sent by the client
+	// but are not in the node transaction pool
+	for nonce := range c.nonceGaps {
+		if nonce < pending {
+			delete(c.nonceGaps, nonce)
+		}
+	}
+	for nonce := pending; nonce < c.nonce.Uint64(); nonce++ {
+		if _, ok := c.nonceGaps[nonce]; !ok {
+			log.Warn().Uint64("mined", mined).Msgf("Detected nonce gap at %d", nonce)
+			c.nonceGaps[nonce] = time.Now()
+		}
+	}
+	return nil
+}
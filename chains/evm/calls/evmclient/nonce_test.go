@@ -0,0 +1,234 @@
+package evmclient
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+)
+
+type testSigner struct{}
+
+func (s *testSigner) CommonAddress() common.Address {
+	return common.HexToAddress("0x1")
+}
+
+func (s *testSigner) Sign(digestHash []byte) ([]byte, error) {
+	return nil, nil
+}
+
+type testTx struct {
+	nonce uint64
+}
+
+func (tx *testTx) Hash() common.Hash {
+	return crypto.Keccak256Hash(new(big.Int).SetUint64(tx.nonce).Bytes())
+}
+
+func (tx *testTx) RawWithSignature(signer Signer, domainID *big.Int) ([]byte, error) {
+	return new(big.Int).SetUint64(tx.nonce).Bytes(), nil
+}
+
+func testTxFabric(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (CommonTransaction, error) {
+	return &testTx{nonce: nonce}, nil
+}
+
+// testMonitor monitors transactions with nonces and bumps gas price by one
+type testMonitor struct {
+	nonces map[uint64]bool
+	bumped []*big.Int
+}
+
+func (m *testMonitor) MonitorsNonce(nonce uint64) bool {
+	return m.nonces[nonce]
+}
+
+func (m *testMonitor) IncreaseGas(oldGp []*big.Int) ([]*big.Int, error) {
+	m.bumped = []*big.Int{new(big.Int).Add(oldGp[0], big.NewInt(1))}
+	return m.bumped, nil
+}
+
+// ethService serves eth_ RPC methods used by nonce management
+type ethService struct {
+	pendingNonce uint64
+	minedNonce   uint64
+	sendErr      error
+	// rejected are nonces whose transactions fail to send
+	rejected map[uint64]bool
+	sent     []uint64
+}
+
+func (s *ethService) GetTransactionCount(address common.Address, block string) hexutil.Uint64 {
+	if block == "pending" {
+		return hexutil.Uint64(s.pendingNonce)
+	}
+	return hexutil.Uint64(s.minedNonce)
+}
+
+func (s *ethService) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(10))
+}
+
+func (s *ethService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (s *ethService) SendRawTransaction(tx hexutil.Bytes) (common.Hash, error) {
+	if s.sendErr != nil {
+		return common.Hash{}, s.sendErr
+	}
+	nonce := new(big.Int).SetBytes(tx).Uint64()
+	if s.rejected[nonce] {
+		return common.Hash{}, errors.New("replacement transaction underpriced")
+	}
+	s.sent = append(s.sent, nonce)
+	return common.Hash{}, nil
+}
+
+type NonceTestSuite struct {
+	suite.Suite
+	service *ethService
+	client  *EVMClient
+	monitor *testMonitor
+}
+
+func TestRunNonceTestSuite(t *testing.T) {
+	suite.Run(t, new(NonceTestSuite))
+}
+
+func (s *NonceTestSuite) SetupTest() {
+	s.service = &ethService{}
+	s.monitor = &testMonitor{nonces: make(map[uint64]bool)}
+	server := rpc.NewServer()
+	s.Nil(server.RegisterName("eth", s.service))
+	rpcClient := rpc.DialInProc(server)
+	s.client = &EVMClient{
+		Client:    ethclient.NewClient(rpcClient),
+		rpClient:  rpcClient,
+		signer:    &testSigner{},
+		nonceGaps: make(map[uint64]time.Time),
+	}
+}
+
+func (s *NonceTestSuite) TestClassifySendError() {
+	s.Equal(SendErrorUnknown, ClassifySendError(nil))
+	s.Equal(SendErrorUnknown, ClassifySendError(errors.New("execution reverted")))
+	s.Equal(SendErrorNonceTooLow, ClassifySendError(errors.New("nonce too low")))
+	s.Equal(SendErrorNonceTooLow, ClassifySendError(errors.New("OldNonce")))
+	s.Equal(SendErrorNonceTooHigh, ClassifySendError(errors.New("nonce too high")))
+	s.Equal(SendErrorAlreadyKnown, ClassifySendError(errors.New("already known")))
+	s.Equal(SendErrorUnderpriced, ClassifySendError(errors.New("replacement transaction underpriced")))
+	s.Equal(SendErrorInsufficientFunds, ClassifySendError(errors.New("insufficient funds for gas * price + value")))
+}
+
+func (s *NonceTestSuite) TestUnsafeNonce_SyncsOnFirstUse() {
+	s.service.pendingNonce = 5
+
+	nonce, err := s.client.UnsafeNonce()
+
+	s.Nil(err)
+	s.Equal(uint64(5), nonce.Uint64())
+}
+
+func (s *NonceTestSuite) TestUnsafeNonce_ResyncsAfterNonceTooLow() {
+	s.service.pendingNonce = 5
+	_, err := s.client.UnsafeNonce()
+	s.Nil(err)
+
+	s.service.pendingNonce = 8
+	s.service.sendErr = errors.New("nonce too low")
+	_, err = s.client.SignAndSendTransaction(context.Background(), &testTx{nonce: 5})
+	s.NotNil(err)
+	nonce, err := s.client.UnsafeNonce()
+
+	s.Nil(err)
+	s.Equal(uint64(8), nonce.Uint64())
+}
+
+func (s *NonceTestSuite) TestSignAndSendTransaction_AlreadyKnownIsNotAnError() {
+	s.service.sendErr = errors.New("already known")
+	tx := &testTx{nonce: 1}
+
+	hash, err := s.client.SignAndSendTransaction(context.Background(), tx)
+
+	s.Nil(err)
+	s.Equal(tx.Hash(), hash)
+}
+
+func (s *NonceTestSuite) TestFillNonceGaps_FillsOnlyLostNonces() {
+	s.service.pendingNonce = 5
+	s.service.minedNonce = 5
+	_, err := s.client.UnsafeNonce()
+	s.Nil(err)
+	s.Nil(s.client.UnsafeIncreaseNonce())
+	s.Nil(s.client.UnsafeIncreaseNonce())
+
+	// nonces 5 and 6 were used locally but are missing from the pool
+	err = s.client.fillNonceGaps(context.Background(), testTxFabric, s.monitor, time.Hour)
+	s.Nil(err)
+	s.Len(s.service.sent, 0)
+
+	err = s.client.fillNonceGaps(context.Background(), testTxFabric, s.monitor, 0)
+	s.Nil(err)
+	s.Equal([]uint64{5, 6}, s.service.sent)
+	s.Len(s.client.nonceGaps, 0)
+	nonce, err := s.client.UnsafeNonce()
+	s.Nil(err)
+	s.Equal(uint64(7), nonce.Uint64())
+}
+
+func (s *NonceTestSuite) TestFillNonceGaps_ClearsGapsFilledByNode() {
+	s.service.pendingNonce = 5
+	_, err := s.client.UnsafeNonce()
+	s.Nil(err)
+	s.Nil(s.client.UnsafeIncreaseNonce())
+
+	err = s.client.fillNonceGaps(context.Background(), testTxFabric, s.monitor, time.Hour)
+	s.Nil(err)
+	s.Len(s.client.nonceGaps, 1)
+
+	s.service.pendingNonce = 6
+	err = s.client.fillNonceGaps(context.Background(), testTxFabric, s.monitor, 0)
+	s.Nil(err)
+	s.Len(s.service.sent, 0)
+	s.Len(s.client.nonceGaps, 0)
+}
+
+func (s *NonceTestSuite) TestFillNonceGaps_SkipsMonitoredNonces() {
+	s.service.pendingNonce = 5
+	_, err := s.client.UnsafeNonce()
+	s.Nil(err)
+	s.Nil(s.client.UnsafeIncreaseNonce())
+	s.Nil(s.client.UnsafeIncreaseNonce())
+	s.monitor.nonces[5] = true
+
+	err = s.client.fillNonceGaps(context.Background(), testTxFabric, s.monitor, 0)
+
+	s.Nil(err)
+	s.Equal([]uint64{6}, s.service.sent)
+	s.Equal([]*big.Int{big.NewInt(11)}, s.monitor.bumped)
+	s.Len(s.client.nonceGaps, 1)
+}
+
+func (s *NonceTestSuite) TestFillNonceGaps_ContinuesAfterFailedFill() {
+	s.service.pendingNonce = 5
+	s.service.rejected = map[uint64]bool{5: true}
+	_, err := s.client.UnsafeNonce()
+	s.Nil(err)
+	s.Nil(s.client.UnsafeIncreaseNonce())
+	s.Nil(s.client.UnsafeIncreaseNonce())
+
+	err = s.client.fillNonceGaps(context.Background(), testTxFabric, s.monitor, 0)
+
+	s.NotNil(err)
+	s.Equal([]uint64{6}, s.service.sent)
+	s.Len(s.client.nonceGaps, 1)
+}
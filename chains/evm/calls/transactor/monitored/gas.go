@@ -0,0 +1,103 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package monitored
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// replacementPriceBump is the minimal gas price increase in percent that
+// nodes accept for a transaction replacing a pending one with the same nonce
+const replacementPriceBump = 10
+
+var ErrGasPriceCapReached = errors.New("gas price cap reached")
+
+// IncreaseGas returns gas prices of a replacement transaction.
+//
+// Legacy gas price is bumped by increasePercentage. Dynamic fee tip cap is
+// bumped by increasePercentage and fee cap is recomputed from the current
+// base fee. Every price is increased at least by the replacement price bump
+// nodes require, ErrGasPriceCapReached is returned if caps don't allow it.
+func (t *MonitoredTransactor) IncreaseGas(oldGp []*big.Int) ([]*big.Int, error) {
+	if len(oldGp) == 2 {
+		return t.increaseDynamicFee(oldGp[0], oldGp[1])
+	}
+
+	gasPrice, err := t.bumpPrice(oldGp[0], t.maxGasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("gas price: %w", err)
+	}
+	return []*big.Int{gasPrice}, nil
+}
+
+func (t *MonitoredTransactor) increaseDynamicFee(oldTipCap *big.Int, oldFeeCap *big.Int) ([]*big.Int, error) {
+	maxTipCap := t.maxGasTipCap
+	if maxTipCap == nil || maxTipCap.Sign() == 0 {
+		maxTipCap = t.maxGasPrice
+	}
+	tipCap, err := t.bumpPrice(oldTipCap, maxTipCap)
+	if err != nil {
+		return nil, fmt.Errorf("tip cap: %w", err)
+	}
+
+	baseFee, err := t.client.BaseFee()
+	if err != nil {
+		return nil, err
+	}
+	feeCap := new(big.Int).Set(tipCap)
+	if baseFee != nil {
+		feeCap.Add(feeCap, new(big.Int).Mul(baseFee, big.NewInt(2)))
+	}
+	minFeeCap := minReplacementPrice(oldFeeCap)
+	if feeCap.Cmp(minFeeCap) < 0 {
+		feeCap = minFeeCap
+	}
+	if feeCap.Cmp(t.maxGasPrice) > 0 {
+		if t.maxGasPrice.Cmp(minFeeCap) < 0 {
+			return nil, fmt.Errorf("fee cap: %w, replacement requires %s but cap is %s", ErrGasPriceCapReached, minFeeCap, t.maxGasPrice)
+		}
+		feeCap = new(big.Int).Set(t.maxGasPrice)
+	}
+
+	// tip cap can't be higher than fee cap
+	if tipCap.Cmp(feeCap) > 0 {
+		minTipCap := minReplacementPrice(oldTipCap)
+		if feeCap.Cmp(minTipCap) < 0 {
+			return nil, fmt.Errorf("tip cap: %w, replacement requires %s but fee cap is %s", ErrGasPriceCapReached, minTipCap, feeCap)
+		}
+		tipCap = new(big.Int).Set(feeCap)
+	}
+	return []*big.Int{tipCap, feeCap}, nil
+}
+
+// bumpPrice increases price by increasePercentage, but at least by the
+// replacement price bump, and limits it to maxPrice
+func (t *MonitoredTransactor) bumpPrice(price *big.Int, maxPrice *big.Int) (*big.Int, error) {
+	minPrice := minReplacementPrice(price)
+	increased := new(big.Int).Add(price, new(big.Int).Div(new(big.Int).Mul(price, t.increasePercentage), big.NewInt(100)))
+	if increased.Cmp(minPrice) < 0 {
+		increased = minPrice
+	}
+
+	if increased.Cmp(maxPrice) > 0 {
+		if maxPrice.Cmp(minPrice) < 0 {
+			return nil, fmt.Errorf("%w, replacement requires %s but cap is %s", ErrGasPriceCapReached, minPrice, maxPrice)
+		}
+		increased = new(big.Int).Set(maxPrice)
+	}
+	return increased, nil
+}
+
+// minReplacementPrice returns price increased by the replacement price bump rounded up
+func minReplacementPrice(price *big.Int) *big.Int {
+	bump := new(big.Int).Mul(price, big.NewInt(replacementPriceBump))
+	bump.Add(bump, big.NewInt(99))
+	bump.Div(bump, big.NewInt(100))
+	if bump.Sign() == 0 {
+		bump.SetInt64(1)
+	}
+	return bump.Add(bump, price)
+}
@@ -0,0 +1,284 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/calls/transactor/monitored/monitored.go
+
+// Package mock_monitored is a generated GoMock package.
+package mock_monitored
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	store "github.com/ChainSafe/chainbridge-core/store"
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTxJournal is a mock of TxJournal interface.
+type MockTxJournal struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxJournalMockRecorder
+}
+
+// MockTxJournalMockRecorder is the mock recorder for MockTxJournal.
+type MockTxJournalMockRecorder struct {
+	mock *MockTxJournal
+}
+
+// NewMockTxJournal creates a new mock instance.
+func NewMockTxJournal(ctrl *gomock.Controller) *MockTxJournal {
+	mock := &MockTxJournal{ctrl: ctrl}
+	mock.recorder = &MockTxJournalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxJournal) EXPECT() *MockTxJournalMockRecorder {
+	return m.recorder
+}
+
+// DeleteTransaction mocks base method.
+func (m *MockTxJournal) DeleteTransaction(domainID uint8, from common.Address, nonce uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", domainID, from, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockTxJournalMockRecorder) DeleteTransaction(domainID, from, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTxJournal)(nil).DeleteTransaction), domainID, from, nonce)
+}
+
+// StoreTransaction mocks base method.
+func (m *MockTxJournal) StoreTransaction(domainID uint8, from common.Address, tx *store.JournaledTx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreTransaction", domainID, from, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreTransaction indicates an expected call of StoreTransaction.
+func (mr *MockTxJournalMockRecorder) StoreTransaction(domainID, from, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTransaction", reflect.TypeOf((*MockTxJournal)(nil).StoreTransaction), domainID, from, tx)
+}
+
+// Transactions mocks base method.
+func (m *MockTxJournal) Transactions(domainID uint8, from common.Address) ([]*store.JournaledTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transactions", domainID, from)
+	ret0, _ := ret[0].([]*store.JournaledTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transactions indicates an expected call of Transactions.
+func (mr *MockTxJournalMockRecorder) Transactions(domainID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transactions", reflect.TypeOf((*MockTxJournal)(nil).Transactions), domainID, from)
+}
+
+// MockTimeoutHandler is a mock of TimeoutHandler interface.
+type MockTimeoutHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeoutHandlerMockRecorder
+}
+
+// MockTimeoutHandlerMockRecorder is the mock recorder for MockTimeoutHandler.
+type MockTimeoutHandlerMockRecorder struct {
+	mock *MockTimeoutHandler
+}
+
+// NewMockTimeoutHandler creates a new mock instance.
+func NewMockTimeoutHandler(ctrl *gomock.Controller) *MockTimeoutHandler {
+	mock := &MockTimeoutHandler{ctrl: ctrl}
+	mock.recorder = &MockTimeoutHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeoutHandler) EXPECT() *MockTimeoutHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleTimeout mocks base method.
+func (m *MockTimeoutHandler) HandleTimeout(hash common.Hash) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTimeout", hash)
+}
+
+// HandleTimeout indicates an expected call of HandleTimeout.
+func (mr *MockTimeoutHandlerMockRecorder) HandleTimeout(hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTimeout", reflect.TypeOf((*MockTimeoutHandler)(nil).HandleTimeout), hash)
+}
+
+// MockChainClient is a mock of ChainClient interface.
+type MockChainClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainClientMockRecorder
+}
+
+// MockChainClientMockRecorder is the mock recorder for MockChainClient.
+type MockChainClientMockRecorder struct {
+	mock *MockChainClient
+}
+
+// NewMockChainClient creates a new mock instance.
+func NewMockChainClient(ctrl *gomock.Controller) *MockChainClient {
+	mock := &MockChainClient{ctrl: ctrl}
+	mock.recorder = &MockChainClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
+	return m.recorder
+}
+
+// BaseFee mocks base method.
+func (m *MockChainClient) BaseFee() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseFee")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BaseFee indicates an expected call of BaseFee.
+func (mr *MockChainClientMockRecorder) BaseFee() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseFee", reflect.TypeOf((*MockChainClient)(nil).BaseFee))
+}
+
+// From mocks base method.
+func (m *MockChainClient) From() common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "From")
+	ret0, _ := ret[0].(common.Address)
+	return ret0
+}
+
+// From indicates an expected call of From.
+func (mr *MockChainClientMockRecorder) From() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "From", reflect.TypeOf((*MockChainClient)(nil).From))
+}
+
+// GetTransactionByHash mocks base method.
+func (m *MockChainClient) GetTransactionByHash(h common.Hash) (*types.Transaction, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByHash", h)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransactionByHash indicates an expected call of GetTransactionByHash.
+func (mr *MockChainClientMockRecorder) GetTransactionByHash(h interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockChainClient)(nil).GetTransactionByHash), h)
+}
+
+// LockNonce mocks base method.
+func (m *MockChainClient) LockNonce() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LockNonce")
+}
+
+// LockNonce indicates an expected call of LockNonce.
+func (mr *MockChainClientMockRecorder) LockNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockNonce", reflect.TypeOf((*MockChainClient)(nil).LockNonce))
+}
+
+// SignAndSendTransaction mocks base method.
+func (m *MockChainClient) SignAndSendTransaction(ctx context.Context, tx evmclient.CommonTransaction) (common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignAndSendTransaction", ctx, tx)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignAndSendTransaction indicates an expected call of SignAndSendTransaction.
+func (mr *MockChainClientMockRecorder) SignAndSendTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignAndSendTransaction", reflect.TypeOf((*MockChainClient)(nil).SignAndSendTransaction), ctx, tx)
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockChainClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockChainClientMockRecorder) TransactionReceipt(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockChainClient)(nil).TransactionReceipt), ctx, txHash)
+}
+
+// UnlockNonce mocks base method.
+func (m *MockChainClient) UnlockNonce() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnlockNonce")
+}
+
+// UnlockNonce indicates an expected call of UnlockNonce.
+func (mr *MockChainClientMockRecorder) UnlockNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockNonce", reflect.TypeOf((*MockChainClient)(nil).UnlockNonce))
+}
+
+// UnsafeIncreaseNonce mocks base method.
+func (m *MockChainClient) UnsafeIncreaseNonce() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsafeIncreaseNonce")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsafeIncreaseNonce indicates an expected call of UnsafeIncreaseNonce.
+func (mr *MockChainClientMockRecorder) UnsafeIncreaseNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeIncreaseNonce", reflect.TypeOf((*MockChainClient)(nil).UnsafeIncreaseNonce))
+}
+
+// UnsafeNonce mocks base method.
+func (m *MockChainClient) UnsafeNonce() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsafeNonce")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsafeNonce indicates an expected call of UnsafeNonce.
+func (mr *MockChainClientMockRecorder) UnsafeNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeNonce", reflect.TypeOf((*MockChainClient)(nil).UnsafeNonce))
+}
+
+// WaitAndReturnTxReceipt mocks base method.
+func (m *MockChainClient) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitAndReturnTxReceipt", h)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitAndReturnTxReceipt indicates an expected call of WaitAndReturnTxReceipt.
+func (mr *MockChainClientMockRecorder) WaitAndReturnTxReceipt(h interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitAndReturnTxReceipt", reflect.TypeOf((*MockChainClient)(nil).WaitAndReturnTxReceipt), h)
+}
@@ -2,7 +2,9 @@ package monitored
 
 import (
 	"context"
+	"errors"
 	"math/big"
+	"sort"
 	"sync"
 	"time"
 
@@ -12,8 +14,22 @@ import (
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/store"
 )
 
+// TimeoutAction is how transactions that exceeded txTimeout are handled
+type TimeoutAction string
+
+const (
+	// TimeoutActionDrop stops monitoring the transaction
+	TimeoutActionDrop TimeoutAction = "drop"
+	// TimeoutActionCancel replaces the transaction with a zero-value self
+	// transfer at a bumped gas price to free its nonce
+	TimeoutActionCancel TimeoutAction = "cancel"
+)
+
+const cancelGasLimit = 21000
+
 type RawTx struct {
 	nonce        uint64
 	to           *common.Address
@@ -23,18 +39,47 @@ type RawTx struct {
 	data         []byte
 	submitTime   time.Time
 	creationTime time.Time
+	// hashes of the transaction and all its replacements
+	hashes []common.Hash
+	// cancelled is set once the transaction was replaced with a self transfer
+	cancelled bool
+	// gasCapReached is set when the transaction can't be resent because of gas price caps
+	gasCapReached bool
+}
+
+type TxJournal interface {
+	StoreTransaction(domainID uint8, from common.Address, tx *store.JournaledTx) error
+	DeleteTransaction(domainID uint8, from common.Address, nonce uint64) error
+	Transactions(domainID uint8, from common.Address) ([]*store.JournaledTx, error)
+}
+
+// TimeoutHandler is notified of transactions that timed out, identified
+// by the hash returned from Transact, so their calls can be retried
+type TimeoutHandler interface {
+	HandleTimeout(hash common.Hash)
+}
+
+type ChainClient interface {
+	calls.ClientDispatcher
+	BaseFee() (*big.Int, error)
 }
 
 type MonitoredTransactor struct {
 	txFabric       calls.TxFabric
 	gasPriceClient calls.GasPricer
-	client         calls.ClientDispatcher
+	client         ChainClient
 
 	maxGasPrice        *big.Int
+	maxGasTipCap       *big.Int
 	increasePercentage *big.Int
+	timeoutAction      TimeoutAction
+	timeoutHandlers    []TimeoutHandler
 
 	pendingTxns map[common.Hash]RawTx
 	txLock      sync.Mutex
+
+	journal  TxJournal
+	domainID uint8
 }
 
 // NewMonitoredTransactor creates an instance of a transactor
@@ -42,13 +87,20 @@ type MonitoredTransactor struct {
 // with higher gas if they are stuck.
 //
 // Gas price is increased by increasePercentage param which
-// is a percentage value with which old gas price should be increased (e.g 15)
+// is a percentage value with which old gas price should be increased (e.g 15).
+// Legacy gas price and dynamic fee cap are limited by maxGasPrice and
+// dynamic tip cap by maxGasTipCap, or by maxGasPrice if it is not set.
+//
+// Transactions that are not included in txTimeout passed to Monitor are
+// handled according to timeoutAction.
 func NewMonitoredTransactor(
 	txFabric calls.TxFabric,
 	gasPriceClient calls.GasPricer,
-	client calls.ClientDispatcher,
+	client ChainClient,
 	maxGasPrice *big.Int,
+	maxGasTipCap *big.Int,
 	increasePercentage *big.Int,
+	timeoutAction TimeoutAction,
 ) *MonitoredTransactor {
 	return &MonitoredTransactor{
 		client:             client,
@@ -56,8 +108,74 @@ func NewMonitoredTransactor(
 		txFabric:           txFabric,
 		pendingTxns:        make(map[common.Hash]RawTx),
 		maxGasPrice:        maxGasPrice,
+		maxGasTipCap:       maxGasTipCap,
 		increasePercentage: increasePercentage,
+		timeoutAction:      timeoutAction,
+	}
+}
+
+// MonitorsNonce reports whether transaction with nonce is monitored,
+// so it will be resent or cancelled by the transactor
+func (t *MonitoredTransactor) MonitorsNonce(nonce uint64) bool {
+	t.txLock.Lock()
+	defer t.txLock.Unlock()
+
+	for _, tx := range t.pendingTxns {
+		if tx.nonce == nonce {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterTimeoutHandler registers handler notified of every timed out transaction
+func (t *MonitoredTransactor) RegisterTimeoutHandler(handler TimeoutHandler) {
+	t.timeoutHandlers = append(t.timeoutHandlers, handler)
+}
+
+// RegisterJournal persists pending transactions to the journal and resumes
+// monitoring of transactions journaled before the restart. Transactions
+// that were included on chain in the meantime are removed from the journal.
+func (t *MonitoredTransactor) RegisterJournal(domainID uint8, journal TxJournal) error {
+	t.journal = journal
+	t.domainID = domainID
+
+	txs, err := journal.Transactions(domainID, t.client.From())
+	if err != nil {
+		return err
+	}
+
+	for _, jtx := range txs {
+		tx := RawTx{
+			nonce:        jtx.Nonce,
+			to:           jtx.To,
+			value:        jtx.Value,
+			gasLimit:     jtx.GasLimit,
+			gasPrice:     jtx.GasPrice,
+			data:         jtx.Data,
+			submitTime:   jtx.SubmitTime,
+			creationTime: jtx.CreationTime,
+			hashes:       jtx.Hashes,
+			cancelled:    jtx.Cancelled,
+		}
+		if len(tx.hashes) == 0 {
+			t.deleteJournaledTx(tx.nonce)
+			continue
+		}
+
+		hash, receipt := t.receipt(context.Background(), tx)
+		if receipt != nil {
+			logReceipt(hash, tx, receipt)
+			t.deleteJournaledTx(tx.nonce)
+			continue
+		}
+
+		log.Info().Uint64("nonce", tx.nonce).Msgf("Resuming monitoring of transaction %s", tx.hashes[len(tx.hashes)-1])
+		t.txLock.Lock()
+		t.pendingTxns[tx.hashes[len(tx.hashes)-1]] = tx
+		t.txLock.Unlock()
 	}
+	return nil
 }
 
 func (t *MonitoredTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
@@ -102,9 +220,11 @@ func (t *MonitoredTransactor) Transact(to *common.Address, data []byte, opts tra
 		return &common.Hash{}, err
 	}
 
+	rawTx.hashes = []common.Hash{h}
 	t.txLock.Lock()
 	t.pendingTxns[h] = rawTx
 	t.txLock.Unlock()
+	t.journalTx(rawTx)
 
 	err = t.client.UnsafeIncreaseNonce()
 	if err != nil {
@@ -128,29 +248,16 @@ func (t *MonitoredTransactor) Monitor(
 			return
 		case <-ticker.C:
 			{
-				t.txLock.Lock()
-				pendingTxCopy := make(map[common.Hash]RawTx, len(t.pendingTxns))
-				for k, v := range t.pendingTxns {
-					pendingTxCopy[k] = v
-				}
-				t.txLock.Unlock()
-
-				for oldHash, tx := range pendingTxCopy {
-					receipt, err := t.client.TransactionReceipt(context.Background(), oldHash)
-					if err == nil {
-						if receipt.Status == types.ReceiptStatusSuccessful {
-							log.Info().Uint64("nonce", tx.nonce).Msgf("Executed transaction %s with nonce %d", oldHash, tx.nonce)
-						} else {
-							log.Error().Uint64("nonce", tx.nonce).Msgf("Transaction %s failed on chain", oldHash)
-						}
-
-						delete(t.pendingTxns, oldHash)
+				for oldHash, tx := range t.pendingTxnsCopy() {
+					hash, receipt := t.receipt(context.Background(), tx)
+					if receipt != nil {
+						logReceipt(hash, tx, receipt)
+						t.removePendingTx(oldHash)
 						continue
 					}
 
 					if time.Since(tx.creationTime) > txTimeout {
-						log.Error().Uint64("nonce", tx.nonce).Msgf("Transaction %s has timed out", oldHash)
-						delete(t.pendingTxns, oldHash)
+						t.handleTimeout(oldHash, tx)
 						continue
 					}
 					if time.Since(tx.submitTime) < tooNewTransaction {
@@ -158,56 +265,265 @@ func (t *MonitoredTransactor) Monitor(
 					}
 
 					hash, err := t.resendTransaction(&tx)
+					if errors.Is(err, ErrGasPriceCapReached) {
+						log.Warn().Uint64("nonce", tx.nonce).Err(err).Msgf("Not resending transaction %s", oldHash)
+						t.markGasCapReached(oldHash)
+						continue
+					}
 					if err != nil {
-						log.Warn().Uint64("nonce", tx.nonce).Err(err).Msgf("Failed resending transaction %s", hash)
+						log.Warn().Uint64("nonce", tx.nonce).Err(err).Msgf("Failed resending transaction %s", oldHash)
 						continue
 					}
 
+					tx.hashes = append(append(make([]common.Hash, 0, len(tx.hashes)+1), tx.hashes...), hash)
+					t.txLock.Lock()
 					delete(t.pendingTxns, oldHash)
 					t.pendingTxns[hash] = tx
+					t.txLock.Unlock()
+					t.journalTx(tx)
 				}
 			}
 		}
 	}
 }
 
-func (t *MonitoredTransactor) resendTransaction(tx *RawTx) (common.Hash, error) {
-	tx.gasPrice = t.IncreaseGas(tx.gasPrice)
-	newTx, err := t.txFabric(tx.nonce, tx.to, tx.value, tx.gasLimit, tx.gasPrice, tx.data)
+// PendingTransaction is a snapshot of a sent transaction that is still monitored
+type PendingTransaction struct {
+	Hash         common.Hash
+	From         common.Address
+	Nonce        uint64
+	To           *common.Address
+	GasPrice     []*big.Int
+	SubmitTime   time.Time
+	CreationTime time.Time
+	Cancelled    bool
+	// GasCapReached is set when the transaction can't be resent because of gas price caps
+	GasCapReached bool
+}
+
+// PendingTransactions returns transactions that are waiting to be included on chain
+func (t *MonitoredTransactor) PendingTransactions() []PendingTransaction {
+	from := t.client.From()
+	t.txLock.Lock()
+	defer t.txLock.Unlock()
+
+	txs := make([]PendingTransaction, 0, len(t.pendingTxns))
+	for hash, tx := range t.pendingTxns {
+		txs = append(txs, PendingTransaction{
+			Hash:          hash,
+			From:          from,
+			Nonce:         tx.nonce,
+			To:            tx.to,
+			GasPrice:      tx.gasPrice,
+			SubmitTime:    tx.submitTime,
+			CreationTime:  tx.creationTime,
+			Cancelled:     tx.cancelled,
+			GasCapReached: tx.gasCapReached,
+		})
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Nonce < txs[j].Nonce
+	})
+	return txs
+}
+
+// WaitPending blocks until all sent transactions are included on chain or ctx
+// is done, checking receipts every checkInterval. Returns transactions that
+// are still pending.
+func (t *MonitoredTransactor) WaitPending(ctx context.Context, checkInterval time.Duration) []PendingTransaction {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		for hash, tx := range t.pendingTxnsCopy() {
+			_, receipt := t.receipt(ctx, tx)
+			if receipt != nil {
+				t.removePendingTx(hash)
+			}
+		}
+
+		pending := t.PendingTransactions()
+		if len(pending) == 0 {
+			return pending
+		}
+
+		select {
+		case <-ctx.Done():
+			return pending
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *MonitoredTransactor) pendingTxnsCopy() map[common.Hash]RawTx {
+	t.txLock.Lock()
+	defer t.txLock.Unlock()
+
+	pendingTxCopy := make(map[common.Hash]RawTx, len(t.pendingTxns))
+	for k, v := range t.pendingTxns {
+		pendingTxCopy[k] = v
+	}
+	return pendingTxCopy
+}
+
+func (t *MonitoredTransactor) removePendingTx(hash common.Hash) {
+	t.txLock.Lock()
+	tx, ok := t.pendingTxns[hash]
+	delete(t.pendingTxns, hash)
+	t.txLock.Unlock()
+
+	if ok {
+		t.deleteJournaledTx(tx.nonce)
+	}
+}
+
+// receipt returns receipt of the transaction or any of its replacements
+// that was included on chain
+func (t *MonitoredTransactor) receipt(ctx context.Context, tx RawTx) (common.Hash, *types.Receipt) {
+	for i := len(tx.hashes) - 1; i >= 0; i-- {
+		receipt, err := t.client.TransactionReceipt(ctx, tx.hashes[i])
+		if err == nil {
+			return tx.hashes[i], receipt
+		}
+	}
+	return common.Hash{}, nil
+}
+
+func logReceipt(hash common.Hash, tx RawTx, receipt *types.Receipt) {
+	nonce := tx.nonce
+	if tx.cancelled {
+		log.Info().Uint64("nonce", nonce).Msgf("Transaction %s with cancelled nonce %d included", hash, nonce)
+		return
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		log.Info().Uint64("nonce", nonce).Msgf("Executed transaction %s with nonce %d", hash, nonce)
+	} else {
+		log.Error().Uint64("nonce", nonce).Msgf("Transaction %s failed on chain", hash)
+	}
+}
+
+func (t *MonitoredTransactor) journalTx(tx RawTx) {
+	if t.journal == nil {
+		return
+	}
+
+	err := t.journal.StoreTransaction(t.domainID, t.client.From(), &store.JournaledTx{
+		Nonce:        tx.nonce,
+		To:           tx.to,
+		Value:        tx.value,
+		GasLimit:     tx.gasLimit,
+		GasPrice:     tx.gasPrice,
+		Data:         tx.data,
+		SubmitTime:   tx.submitTime,
+		CreationTime: tx.creationTime,
+		Hashes:       tx.hashes,
+		Cancelled:    tx.cancelled,
+	})
 	if err != nil {
-		return common.Hash{}, err
+		log.Error().Uint64("nonce", tx.nonce).Err(err).Msg("Failed journaling transaction")
 	}
+}
 
-	hash, err := t.client.SignAndSendTransaction(context.TODO(), newTx)
+func (t *MonitoredTransactor) deleteJournaledTx(nonce uint64) {
+	if t.journal == nil {
+		return
+	}
+
+	err := t.journal.DeleteTransaction(t.domainID, t.client.From(), nonce)
+	if err != nil {
+		log.Error().Uint64("nonce", nonce).Err(err).Msg("Failed removing transaction from journal")
+	}
+}
+
+// handleTimeout cancels or drops timed out transaction. Handlers are notified
+// once per transaction, timed out cancellations are dropped.
+func (t *MonitoredTransactor) handleTimeout(hash common.Hash, tx RawTx) {
+	if t.timeoutAction == TimeoutActionCancel && !tx.cancelled {
+		cancelHash, err := t.cancelTransaction(&tx)
+		if err == nil {
+			log.Warn().Uint64("nonce", tx.nonce).Msgf("Transaction %s has timed out, cancelling it with %s", hash, cancelHash)
+			t.txLock.Lock()
+			delete(t.pendingTxns, hash)
+			t.pendingTxns[cancelHash] = tx
+			t.txLock.Unlock()
+			t.journalTx(tx)
+			t.notifyTimeout(tx)
+			return
+		}
+		log.Error().Uint64("nonce", tx.nonce).Err(err).Msgf("Failed cancelling timed out transaction %s", hash)
+	}
+
+	log.Error().Uint64("nonce", tx.nonce).Msgf("Transaction %s has timed out", hash)
+	t.removePendingTx(hash)
+	if !tx.cancelled {
+		t.notifyTimeout(tx)
+	}
+}
+
+// cancelTransaction sends zero-value self transfer with the same nonce
+// and bumped gas price
+func (t *MonitoredTransactor) cancelTransaction(tx *RawTx) (common.Hash, error) {
+	from := t.client.From()
+	gasPrice, err := t.IncreaseGas(tx.gasPrice)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	cancelTx, err := t.txFabric(tx.nonce, &from, big.NewInt(0), cancelGasLimit, gasPrice, nil)
 	if err != nil {
 		return common.Hash{}, err
 	}
 
-	log.Debug().Uint64("nonce", tx.nonce).Msgf("Resent transaction with hash %s", hash)
+	hash, err := t.client.SignAndSendTransaction(context.TODO(), cancelTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
 
+	tx.to = &from
+	tx.value = big.NewInt(0)
+	tx.gasLimit = cancelGasLimit
+	tx.gasPrice = gasPrice
+	tx.gasCapReached = false
+	tx.data = nil
+	tx.submitTime = time.Now()
+	tx.creationTime = time.Now()
+	tx.hashes = append(append(make([]common.Hash, 0, len(tx.hashes)+1), tx.hashes...), hash)
+	tx.cancelled = true
 	return hash, nil
 }
 
-// increase gas bumps gas price by preset percentage.
-//
-// If gas was 10 and the increaseFactor is 15 the new gas price
-// would be 11 (it floors the value). In case the gas price didn't
-// change it increases it by 1.
-func (t *MonitoredTransactor) IncreaseGas(oldGp []*big.Int) []*big.Int {
-	newGp := make([]*big.Int, len(oldGp))
-	for i, gp := range oldGp {
-
-		percentIncreaseValue := new(big.Int).Div(new(big.Int).Mul(gp, t.increasePercentage), big.NewInt(100))
-		increasedGp := new(big.Int).Add(gp, percentIncreaseValue)
-		if increasedGp.Cmp(t.maxGasPrice) != -1 {
-			increasedGp = t.maxGasPrice
-		}
+func (t *MonitoredTransactor) notifyTimeout(tx RawTx) {
+	for _, handler := range t.timeoutHandlers {
+		handler.HandleTimeout(tx.hashes[0])
+	}
+}
 
-		if gp.Cmp(increasedGp) == 0 {
-			newGp[i] = new(big.Int).Add(gp, big.NewInt(1))
-		} else {
-			newGp[i] = increasedGp
-		}
+func (t *MonitoredTransactor) markGasCapReached(hash common.Hash) {
+	t.txLock.Lock()
+	defer t.txLock.Unlock()
+
+	if tx, ok := t.pendingTxns[hash]; ok {
+		tx.gasCapReached = true
+		t.pendingTxns[hash] = tx
 	}
-	return newGp
+}
+
+func (t *MonitoredTransactor) resendTransaction(tx *RawTx) (common.Hash, error) {
+	gasPrice, err := t.IncreaseGas(tx.gasPrice)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	newTx, err := t.txFabric(tx.nonce, tx.to, tx.value, tx.gasLimit, gasPrice, tx.data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := t.client.SignAndSendTransaction(context.TODO(), newTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	log.Debug().Uint64("nonce", tx.nonce).Msgf("Resent transaction with hash %s", hash)
+	tx.gasPrice = gasPrice
+	tx.gasCapReached = false
+	return hash, nil
 }
@@ -7,11 +7,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
 	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	mock_transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/mock"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	mock_monitored "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored/mock"
+	"github.com/ChainSafe/chainbridge-core/store"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
@@ -20,10 +23,11 @@ import (
 
 type TransactorTestSuite struct {
 	suite.Suite
-	gomockController                   *gomock.Controller
-	mockContractCallerDispatcherClient *mock_calls.MockContractCallerDispatcher
-	mockTransactor                     *mock_transactor.MockTransactor
-	mockGasPricer                      *mock_calls.MockGasPricer
+	gomockController *gomock.Controller
+	mockClient       *mock_monitored.MockChainClient
+	mockTransactor   *mock_transactor.MockTransactor
+	mockGasPricer    *mock_calls.MockGasPricer
+	mockTxJournal    *mock_monitored.MockTxJournal
 }
 
 func TestMonitoredTransactorTestSuite(t *testing.T) {
@@ -32,27 +36,30 @@ func TestMonitoredTransactorTestSuite(t *testing.T) {
 
 func (s *TransactorTestSuite) SetupTest() {
 	s.gomockController = gomock.NewController(s.T())
-	s.mockContractCallerDispatcherClient = mock_calls.NewMockContractCallerDispatcher(s.gomockController)
+	s.mockClient = mock_monitored.NewMockChainClient(s.gomockController)
 	s.mockTransactor = mock_transactor.NewMockTransactor(s.gomockController)
 	s.mockGasPricer = mock_calls.NewMockGasPricer(s.gomockController)
+	s.mockTxJournal = mock_monitored.NewMockTxJournal(s.gomockController)
 }
 
 func (s *TransactorTestSuite) TestTransactor_SignAndSend_Success() {
 	var byteData = []byte{47, 47, 241, 93, 159, 45, 240, 254, 210, 199, 118, 72, 222, 88, 96, 164, 204, 80, 140, 208, 129, 140, 133, 184, 184, 161, 171, 76, 238, 239, 141, 152, 28, 137, 86, 166, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 48, 181, 109, 237, 4, 127, 230, 34, 95, 112, 4, 234, 75, 225, 174, 112, 201, 2, 106}
 
-	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
 	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(1)}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
 
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
+		s.mockClient,
 		big.NewInt(1000),
-		big.NewInt(15))
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
 	txHash, err := t.Transact(
 		&common.Address{},
 		byteData,
@@ -61,23 +68,31 @@ func (s *TransactorTestSuite) TestTransactor_SignAndSend_Success() {
 
 	s.Nil(err)
 	s.Equal("0x0102030405000000000000000000000000000000000000000000000000000000", txHash.String())
+	s.mockClient.EXPECT().From().Return(common.HexToAddress("0x1"))
+	pending := t.PendingTransactions()
+	s.Len(pending, 1)
+	s.Equal(*txHash, pending[0].Hash)
+	s.Equal(common.HexToAddress("0x1"), pending[0].From)
+	s.Equal(uint64(1), pending[0].Nonce)
 }
 
 func (s *TransactorTestSuite) TestTransactor_SignAndSend_Fail() {
 	var byteData = []byte{47, 47, 241, 93, 159, 45, 240, 254, 210, 199, 118, 72, 222, 88, 96, 164, 204, 80, 140, 208, 129, 140, 133, 184, 184, 161, 171, 76, 238, 239, 141, 152, 28, 137, 86, 166, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 48, 181, 109, 237, 4, 127, 230, 34, 95, 112, 4, 234, 75, 225, 174, 112, 201, 2, 106}
 
-	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
 	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(1)}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, fmt.Errorf("error"))
-	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, fmt.Errorf("error"))
+	s.mockClient.EXPECT().UnlockNonce()
 
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
+		s.mockClient,
 		big.NewInt(1000),
-		big.NewInt(15))
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
 	_, err := t.Transact(
 		&common.Address{},
 		byteData,
@@ -91,20 +106,22 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_SuccessfulExec
 	var byteData = []byte{47, 47, 241, 93, 159, 45, 240, 254, 210, 199, 118, 72, 222, 88, 96, 164, 204, 80, 140, 208, 129, 140, 133, 184, 184, 161, 171, 76, 238, 239, 141, 152, 28, 137, 86, 166, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 48, 181, 109, 237, 4, 127, 230, 34, 95, 112, 4, 234, 75, 225, 174, 112, 201, 2, 106}
 
 	// Sending transaction
-	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
 	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(1)}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
+		s.mockClient,
 		big.NewInt(1000),
-		big.NewInt(15))
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
 
 	go t.Monitor(ctx, time.Millisecond*50, time.Minute, time.Millisecond)
 	hash, err := t.Transact(
@@ -113,7 +130,7 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_SuccessfulExec
 		transactor.TransactOptions{},
 	)
 	// Transaction executed
-	s.mockContractCallerDispatcherClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(&types.Receipt{
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(&types.Receipt{
 		Status: types.ReceiptStatusSuccessful,
 	}, nil)
 	s.Nil(err)
@@ -126,20 +143,22 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_TxTimeout() {
 	var byteData = []byte{47, 47, 241, 93, 159, 45, 240, 254, 210, 199, 118, 72, 222, 88, 96, 164, 204, 80, 140, 208, 129, 140, 133, 184, 184, 161, 171, 76, 238, 239, 141, 152, 28, 137, 86, 166, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 48, 181, 109, 237, 4, 127, 230, 34, 95, 112, 4, 234, 75, 225, 174, 112, 201, 2, 106}
 
 	// Sending transaction
-	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
 	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(1)}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
+		s.mockClient,
 		big.NewInt(1000),
-		big.NewInt(15))
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
 
 	go t.Monitor(ctx, time.Millisecond*50, time.Millisecond, time.Millisecond)
 	hash, err := t.Transact(
@@ -147,7 +166,7 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_TxTimeout() {
 		byteData,
 		transactor.TransactOptions{},
 	)
-	s.mockContractCallerDispatcherClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found"))
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found"))
 	s.Nil(err)
 
 	time.Sleep(time.Millisecond * 150)
@@ -158,23 +177,25 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_TransactionRes
 	var byteData = []byte{47, 47, 241, 93, 159, 45, 240, 254, 210, 199, 118, 72, 222, 88, 96, 164, 204, 80, 140, 208, 129, 140, 133, 184, 184, 161, 171, 76, 238, 239, 141, 152, 28, 137, 86, 166, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 48, 181, 109, 237, 4, 127, 230, 34, 95, 112, 4, 234, 75, 225, 174, 112, 201, 2, 106}
 
 	// Sending transaction
-	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
 	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(10)}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
 
 	// Resending transaction
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
+		s.mockClient,
 		big.NewInt(1000),
-		big.NewInt(15))
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
 
 	go t.Monitor(ctx, time.Millisecond*50, time.Minute, time.Millisecond)
 	hash, err := t.Transact(
@@ -184,8 +205,8 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_TransactionRes
 	)
 	s.Nil(err)
 
-	s.mockContractCallerDispatcherClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found"))
-	s.mockContractCallerDispatcherClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1, 2, 3, 4, 5}).Return(&types.Receipt{
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found"))
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1, 2, 3, 4, 5}).Return(&types.Receipt{
 		Status: types.ReceiptStatusFailed,
 	}, nil)
 
@@ -197,23 +218,22 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_MaxGasPriceRea
 	var byteData = []byte{47, 47, 241, 93, 159, 45, 240, 254, 210, 199, 118, 72, 222, 88, 96, 164, 204, 80, 140, 208, 129, 140, 133, 184, 184, 161, 171, 76, 238, 239, 141, 152, 28, 137, 86, 166, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 48, 181, 109, 237, 4, 127, 230, 34, 95, 112, 4, 234, 75, 225, 174, 112, 201, 2, 106}
 
 	// Sending transaction
-	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
 	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(11)}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
-	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
-
-	// Resending transaction
-	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
+		s.mockClient,
 		big.NewInt(10),
-		big.NewInt(15))
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
 
 	go t.Monitor(ctx, time.Millisecond*50, time.Minute, time.Millisecond)
 	hash, err := t.Transact(
@@ -223,37 +243,322 @@ func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_MaxGasPriceRea
 	)
 	s.Nil(err)
 
-	s.mockContractCallerDispatcherClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found"))
-	s.mockContractCallerDispatcherClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1, 2, 3, 4, 5}).Return(&types.Receipt{
-		Status: types.ReceiptStatusFailed,
-	}, nil)
+	// transaction is not resent as replacement would exceed max gas price
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	time.Sleep(time.Millisecond * 125)
 	cancel()
+
+	s.mockClient.EXPECT().From().Return(common.HexToAddress("0x1"))
+	pending := t.PendingTransactions()
+	s.Len(pending, 1)
+	s.True(pending[0].GasCapReached)
+}
+
+func (s *TransactorTestSuite) newGasTransactor(maxGasPrice *big.Int, maxGasTipCap *big.Int) *monitored.MonitoredTransactor {
+	return monitored.NewMonitoredTransactor(
+		evmtransaction.NewTransaction,
+		s.mockGasPricer,
+		s.mockClient,
+		maxGasPrice,
+		maxGasTipCap,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
 }
 
 func (s *TransactorTestSuite) TestTransactor_IncreaseGas_15PercentIncrease() {
+	t := s.newGasTransactor(big.NewInt(150), nil)
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(100)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(115)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_MinimalReplacementIncrease() {
+	t := s.newGasTransactor(big.NewInt(150), nil)
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(1)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(2)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_MaxGasReached() {
+	t := s.newGasTransactor(big.NewInt(112), nil)
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(100)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(112)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_ReplacementAboveMaxGas() {
+	t := s.newGasTransactor(big.NewInt(105), nil)
+
+	_, err := t.IncreaseGas([]*big.Int{big.NewInt(100)})
+
+	s.ErrorIs(err, monitored.ErrGasPriceCapReached)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_DynamicFeeFromBaseFee() {
+	s.mockClient.EXPECT().BaseFee().Return(big.NewInt(200), nil)
+	t := s.newGasTransactor(big.NewInt(1000), nil)
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(100), big.NewInt(300)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(115), big.NewInt(515)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_DynamicFeeMinimalReplacementIncrease() {
+	s.mockClient.EXPECT().BaseFee().Return(big.NewInt(10), nil)
+	t := s.newGasTransactor(big.NewInt(1000), nil)
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(100), big.NewInt(500)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(115), big.NewInt(550)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_DynamicFeeSeparateTipCap() {
+	s.mockClient.EXPECT().BaseFee().Return(big.NewInt(200), nil)
+	t := s.newGasTransactor(big.NewInt(1000), big.NewInt(112))
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(100), big.NewInt(300)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(112), big.NewInt(512)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_DynamicFeeCappedByMaxGas() {
+	s.mockClient.EXPECT().BaseFee().Return(big.NewInt(500), nil)
+	t := s.newGasTransactor(big.NewInt(400), nil)
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(100), big.NewInt(300)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(115), big.NewInt(400)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_DynamicFeeTipCapLimitedByFeeCap() {
+	s.mockClient.EXPECT().BaseFee().Return(big.NewInt(0), nil)
+	t := s.newGasTransactor(big.NewInt(112), big.NewInt(200))
+
+	newGas, err := t.IncreaseGas([]*big.Int{big.NewInt(100), big.NewInt(100)})
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(112), big.NewInt(112)}, newGas)
+}
+
+func (s *TransactorTestSuite) TestTransactor_IncreaseGas_DynamicFeeAboveMaxGas() {
+	s.mockClient.EXPECT().BaseFee().Return(big.NewInt(500), nil)
+	t := s.newGasTransactor(big.NewInt(320), nil)
+
+	_, err := t.IncreaseGas([]*big.Int{big.NewInt(100), big.NewInt(300)})
+
+	s.ErrorIs(err, monitored.ErrGasPriceCapReached)
+}
+
+func (s *TransactorTestSuite) TestTransactor_WaitPending_ReturnsOnceTransactionsIncluded() {
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(1)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
-		big.NewInt(150),
-		big.NewInt(15))
+		s.mockClient,
+		big.NewInt(1000),
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
+	hash, err := t.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
 
-	newGas := t.IncreaseGas([]*big.Int{big.NewInt(1), big.NewInt(10), big.NewInt(100)})
+	s.mockClient.EXPECT().From().Return(common.HexToAddress("0x1")).AnyTimes()
+	gomock.InOrder(
+		s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found")),
+		s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(&types.Receipt{}, nil),
+	)
+	pending := t.WaitPending(context.Background(), time.Millisecond)
 
-	s.Equal(newGas, []*big.Int{big.NewInt(2), big.NewInt(11), big.NewInt(115)})
+	s.Len(pending, 0)
 }
 
-func (s *TransactorTestSuite) TestTransactor_IncreaseGas_MaxGasReached() {
+func (s *TransactorTestSuite) TestTransactor_WaitPending_ReturnsPendingTransactionsOnDeadline() {
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(1)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
+	t := monitored.NewMonitoredTransactor(
+		evmtransaction.NewTransaction,
+		s.mockGasPricer,
+		s.mockClient,
+		big.NewInt(1000),
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
+	hash, err := t.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	s.mockClient.EXPECT().From().Return(common.HexToAddress("0x1")).AnyTimes()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+	pending := t.WaitPending(ctx, time.Millisecond*5)
+
+	s.Len(pending, 1)
+	s.Equal(*hash, pending[0].Hash)
+}
+
+func (s *TransactorTestSuite) TestTransactor_Journal_StoresAndRemovesTransaction() {
+	from := common.HexToAddress("0x1")
+	s.mockClient.EXPECT().From().Return(from).AnyTimes()
+	s.mockTxJournal.EXPECT().Transactions(uint8(1), from).Return([]*store.JournaledTx{}, nil)
+	t := monitored.NewMonitoredTransactor(
+		evmtransaction.NewTransaction,
+		s.mockGasPricer,
+		s.mockClient,
+		big.NewInt(1000),
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
+	err := t.RegisterJournal(1, s.mockTxJournal)
+	s.Nil(err)
+
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(1)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
+	s.mockTxJournal.EXPECT().StoreTransaction(uint8(1), from, gomock.Any()).DoAndReturn(func(domainID uint8, from common.Address, tx *store.JournaledTx) error {
+		s.Equal(uint64(1), tx.Nonce)
+		s.Equal([]*big.Int{big.NewInt(1)}, tx.GasPrice)
+		s.Equal([]common.Hash{{1, 2, 3, 4, 5}}, tx.Hashes)
+		return nil
+	})
+	hash, err := t.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(&types.Receipt{}, nil)
+	s.mockTxJournal.EXPECT().DeleteTransaction(uint8(1), from, uint64(1)).Return(nil)
+	pending := t.WaitPending(context.Background(), time.Millisecond)
+
+	s.Len(pending, 0)
+}
+
+func (s *TransactorTestSuite) TestTransactor_RegisterJournal_ResumesPendingTransactions() {
+	from := common.HexToAddress("0x1")
+	s.mockClient.EXPECT().From().Return(from).AnyTimes()
+	s.mockTxJournal.EXPECT().Transactions(uint8(1), from).Return([]*store.JournaledTx{
+		{Nonce: 1, GasPrice: []*big.Int{big.NewInt(1)}, Hashes: []common.Hash{{1}, {2}}},
+		{Nonce: 2, GasPrice: []*big.Int{big.NewInt(1)}, Hashes: []common.Hash{{3}, {4}}},
+	}, nil)
+	// replacement of the first transaction is not included but the original is
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(nil, fmt.Errorf("not found"))
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{}, nil)
+	s.mockTxJournal.EXPECT().DeleteTransaction(uint8(1), from, uint64(1)).Return(nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{4}).Return(nil, fmt.Errorf("not found"))
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{3}).Return(nil, fmt.Errorf("not found"))
 	t := monitored.NewMonitoredTransactor(
 		evmtransaction.NewTransaction,
 		s.mockGasPricer,
-		s.mockContractCallerDispatcherClient,
+		s.mockClient,
+		big.NewInt(1000),
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionDrop)
+
+	err := t.RegisterJournal(1, s.mockTxJournal)
+
+	s.Nil(err)
+	pending := t.PendingTransactions()
+	s.Len(pending, 1)
+	s.Equal(common.Hash{4}, pending[0].Hash)
+	s.Equal(uint64(2), pending[0].Nonce)
+}
+
+func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_TxTimeout_CancelsTransaction() {
+	from := common.HexToAddress("0x1")
+	mockTimeoutHandler := mock_monitored.NewMockTimeoutHandler(s.gomockController)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
+	ctx, cancel := context.WithCancel(context.Background())
+	txs := make(chan []interface{}, 2)
+	txFabric := func(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (evmclient.CommonTransaction, error) {
+		txs <- []interface{}{nonce, to, amount, gasLimit, gasPrices, data}
+		return evmtransaction.NewTransaction(nonce, to, amount, gasLimit, gasPrices, data)
+	}
+	t := monitored.NewMonitoredTransactor(
+		txFabric,
+		s.mockGasPricer,
+		s.mockClient,
+		big.NewInt(1000),
+		nil,
 		big.NewInt(15),
-		big.NewInt(15))
+		monitored.TimeoutActionCancel)
+	t.RegisterTimeoutHandler(mockTimeoutHandler)
+	hash, err := t.Transact(&common.Address{}, []byte{1}, transactor.TransactOptions{})
+	s.Nil(err)
+
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found"))
+	s.mockClient.EXPECT().From().Return(from)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{9}, nil)
+	mockTimeoutHandler.EXPECT().HandleTimeout(*hash)
+	go t.Monitor(ctx, time.Millisecond*50, time.Millisecond, time.Millisecond)
 
-	newGas := t.IncreaseGas([]*big.Int{big.NewInt(1), big.NewInt(10), big.NewInt(100)})
+	time.Sleep(time.Millisecond * 75)
+	cancel()
+
+	<-txs
+	s.Equal([]interface{}{uint64(1), &from, big.NewInt(0), uint64(21000), []*big.Int{big.NewInt(115)}, []byte(nil)}, <-txs)
+	s.mockClient.EXPECT().From().Return(from)
+	pending := t.PendingTransactions()
+	s.Len(pending, 1)
+	s.Equal(common.Hash{9}, pending[0].Hash)
+	s.True(pending[0].Cancelled)
+}
+
+func (s *TransactorTestSuite) TestTransactor_MonitoredTransaction_TxTimeout_DropsTransactionIfCancellationFails() {
+	mockTimeoutHandler := mock_monitored.NewMockTimeoutHandler(s.gomockController)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1, 2, 3, 4, 5}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
+	ctx, cancel := context.WithCancel(context.Background())
+	t := monitored.NewMonitoredTransactor(
+		evmtransaction.NewTransaction,
+		s.mockGasPricer,
+		s.mockClient,
+		big.NewInt(1000),
+		nil,
+		big.NewInt(15),
+		monitored.TimeoutActionCancel)
+	t.RegisterTimeoutHandler(mockTimeoutHandler)
+	hash, err := t.Transact(&common.Address{}, []byte{1}, transactor.TransactOptions{})
+	s.Nil(err)
+
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), *hash).Return(nil, fmt.Errorf("not found"))
+	s.mockClient.EXPECT().From().Return(common.HexToAddress("0x1"))
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, fmt.Errorf("error"))
+	mockTimeoutHandler.EXPECT().HandleTimeout(*hash)
+	go t.Monitor(ctx, time.Millisecond*50, time.Millisecond, time.Millisecond)
+
+	time.Sleep(time.Millisecond * 75)
+	cancel()
 
-	s.Equal(newGas, []*big.Int{big.NewInt(2), big.NewInt(11), big.NewInt(15)})
+	s.mockClient.EXPECT().From().Return(common.HexToAddress("0x1"))
+	s.Len(t.PendingTransactions(), 0)
 }
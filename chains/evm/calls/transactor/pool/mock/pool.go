@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/calls/transactor/pool/pool.go
+
+// Package mock_pool is a generated GoMock package.
+package mock_pool
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	monitored "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockKeyTransactor is a mock of KeyTransactor interface.
+type MockKeyTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyTransactorMockRecorder
+}
+
+// MockKeyTransactorMockRecorder is the mock recorder for MockKeyTransactor.
+type MockKeyTransactorMockRecorder struct {
+	mock *MockKeyTransactor
+}
+
+// NewMockKeyTransactor creates a new mock instance.
+func NewMockKeyTransactor(ctrl *gomock.Controller) *MockKeyTransactor {
+	mock := &MockKeyTransactor{ctrl: ctrl}
+	mock.recorder = &MockKeyTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyTransactor) EXPECT() *MockKeyTransactorMockRecorder {
+	return m.recorder
+}
+
+// PendingTransactions mocks base method.
+func (m *MockKeyTransactor) PendingTransactions() []monitored.PendingTransaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingTransactions")
+	ret0, _ := ret[0].([]monitored.PendingTransaction)
+	return ret0
+}
+
+// PendingTransactions indicates an expected call of PendingTransactions.
+func (mr *MockKeyTransactorMockRecorder) PendingTransactions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTransactions", reflect.TypeOf((*MockKeyTransactor)(nil).PendingTransactions))
+}
+
+// Transact mocks base method.
+func (m *MockKeyTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transact", to, data, opts)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transact indicates an expected call of Transact.
+func (mr *MockKeyTransactorMockRecorder) Transact(to, data, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transact", reflect.TypeOf((*MockKeyTransactor)(nil).Transact), to, data, opts)
+}
+
+// MockRelayerSet is a mock of RelayerSet interface.
+type MockRelayerSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerSetMockRecorder
+}
+
+// MockRelayerSetMockRecorder is the mock recorder for MockRelayerSet.
+type MockRelayerSetMockRecorder struct {
+	mock *MockRelayerSet
+}
+
+// NewMockRelayerSet creates a new mock instance.
+func NewMockRelayerSet(ctrl *gomock.Controller) *MockRelayerSet {
+	mock := &MockRelayerSet{ctrl: ctrl}
+	mock.recorder = &MockRelayerSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerSet) EXPECT() *MockRelayerSetMockRecorder {
+	return m.recorder
+}
+
+// IsRelayer mocks base method.
+func (m *MockRelayerSet) IsRelayer(relayerAddress common.Address) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRelayer", relayerAddress)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRelayer indicates an expected call of IsRelayer.
+func (mr *MockRelayerSetMockRecorder) IsRelayer(relayerAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRelayer", reflect.TypeOf((*MockRelayerSet)(nil).IsRelayer), relayerAddress)
+}
+
+// MockBalanceClient is a mock of BalanceClient interface.
+type MockBalanceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceClientMockRecorder
+}
+
+// MockBalanceClientMockRecorder is the mock recorder for MockBalanceClient.
+type MockBalanceClientMockRecorder struct {
+	mock *MockBalanceClient
+}
+
+// NewMockBalanceClient creates a new mock instance.
+func NewMockBalanceClient(ctrl *gomock.Controller) *MockBalanceClient {
+	mock := &MockBalanceClient{ctrl: ctrl}
+	mock.recorder = &MockBalanceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceClient) EXPECT() *MockBalanceClientMockRecorder {
+	return m.recorder
+}
+
+// BalanceAt mocks base method.
+func (m *MockBalanceClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockBalanceClientMockRecorder) BalanceAt(ctx, account, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockBalanceClient)(nil).BalanceAt), ctx, account, blockNumber)
+}
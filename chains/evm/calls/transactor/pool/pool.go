@@ -0,0 +1,205 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package pool
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// Selection is how the pool picks a key for a transaction
+type Selection string
+
+const (
+	// SelectionRoundRobin sends transactions with keys in turns
+	SelectionRoundRobin Selection = "round-robin"
+	// SelectionLeastPending sends transactions with the key that has
+	// the fewest transactions waiting to be included
+	SelectionLeastPending Selection = "least-pending"
+)
+
+var (
+	ErrNoKeys        = errors.New("no keys registered")
+	ErrNoRelayerKeys = errors.New("none of the keys is a relayer")
+)
+
+type KeyTransactor interface {
+	transactor.Transactor
+	PendingTransactions() []monitored.PendingTransaction
+}
+
+type RelayerSet interface {
+	IsRelayer(relayerAddress common.Address) (bool, error)
+}
+
+type BalanceClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// KeyStatus is the balance and the number of pending transactions of a pool key
+type KeyStatus struct {
+	Address common.Address
+	Balance *big.Int
+	Pending int
+}
+
+type key struct {
+	address    common.Address
+	transactor KeyTransactor
+}
+
+// TransactorPool is a transactor that spreads transactions over several
+// signing keys, each with its own nonce tracking, so a stuck transaction
+// of one key doesn't stall transactions sent with the others.
+type TransactorPool struct {
+	client     BalanceClient
+	selection  Selection
+	relayerSet RelayerSet
+
+	keysLock sync.Mutex
+	keys     []key
+	next     int
+}
+
+func NewTransactorPool(client BalanceClient, selection Selection) *TransactorPool {
+	return &TransactorPool{
+		client:    client,
+		selection: selection,
+	}
+}
+
+// RegisterKey adds transactor that signs transactions with key of address to the pool
+func (p *TransactorPool) RegisterKey(address common.Address, transactor KeyTransactor) {
+	p.keysLock.Lock()
+	defer p.keysLock.Unlock()
+
+	p.keys = append(p.keys, key{address: address, transactor: transactor})
+}
+
+// RegisterRelayerSet enables skipping keys that are not bridge relayers
+func (p *TransactorPool) RegisterRelayerSet(relayerSet RelayerSet) {
+	p.relayerSet = relayerSet
+}
+
+// Transact sends transaction with the key picked by the pool selection.
+// If the node rejects the transaction, so it certainly wasn't broadcast,
+// the transaction is sent with the next key in order. Keys that are
+// not relayers are skipped.
+func (p *TransactorPool) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	keys := p.orderedKeys()
+	if len(keys) == 0 {
+		return &common.Hash{}, ErrNoKeys
+	}
+
+	err := ErrNoRelayerKeys
+	for _, k := range keys {
+		isRelayer, relayerErr := p.isRelayer(k.address)
+		if relayerErr != nil {
+			log.Warn().Err(relayerErr).Msgf("Failed checking if key %s is a relayer", k.address)
+			err = relayerErr
+			continue
+		}
+		if !isRelayer {
+			log.Error().Msgf("Key %s is not a relayer, skipping it", k.address)
+			continue
+		}
+
+		var hash *common.Hash
+		hash, err = k.transactor.Transact(to, data, opts)
+		if err == nil {
+			return hash, nil
+		}
+		if !notBroadcast(err) {
+			return &common.Hash{}, err
+		}
+		log.Warn().Err(err).Msgf("Failed sending transaction with key %s", k.address)
+	}
+	return &common.Hash{}, err
+}
+
+func (p *TransactorPool) isRelayer(address common.Address) (bool, error) {
+	if p.relayerSet == nil {
+		return true, nil
+	}
+	return p.relayerSet.IsRelayer(address)
+}
+
+// notBroadcast reports whether the node rejected the transaction, so sending it
+// with another key can't result in the same call being executed twice
+func notBroadcast(err error) bool {
+	switch evmclient.ClassifySendError(err) {
+	case evmclient.SendErrorNonceTooLow, evmclient.SendErrorNonceTooHigh, evmclient.SendErrorUnderpriced, evmclient.SendErrorInsufficientFunds:
+		return true
+	}
+	return false
+}
+
+// PendingTransactions returns transactions of all keys that are waiting to be included on chain
+func (p *TransactorPool) PendingTransactions() []monitored.PendingTransaction {
+	txs := make([]monitored.PendingTransaction, 0)
+	for _, k := range p.keysCopy() {
+		txs = append(txs, k.transactor.PendingTransactions()...)
+	}
+	return txs
+}
+
+// Keys returns balances and pending transaction counts of pool keys
+func (p *TransactorPool) Keys(ctx context.Context) ([]KeyStatus, error) {
+	keys := p.keysCopy()
+	statuses := make([]KeyStatus, len(keys))
+	for i, k := range keys {
+		balance, err := p.client.BalanceAt(ctx, k.address, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses[i] = KeyStatus{
+			Address: k.address,
+			Balance: balance,
+			Pending: len(k.transactor.PendingTransactions()),
+		}
+	}
+	return statuses, nil
+}
+
+// orderedKeys returns keys in order in which they should be used for
+// the next transaction. Keys are rotated on every call so keys with
+// the same number of pending transactions are used in turns.
+func (p *TransactorPool) orderedKeys() []key {
+	p.keysLock.Lock()
+	if len(p.keys) == 0 {
+		p.keysLock.Unlock()
+		return nil
+	}
+	keys := append(append(make([]key, 0, len(p.keys)), p.keys[p.next:]...), p.keys[:p.next]...)
+	p.next = (p.next + 1) % len(p.keys)
+	p.keysLock.Unlock()
+
+	if p.selection == SelectionLeastPending {
+		pending := make(map[common.Address]int, len(keys))
+		for _, k := range keys {
+			pending[k.address] = len(k.transactor.PendingTransactions())
+		}
+		sort.SliceStable(keys, func(i, j int) bool {
+			return pending[keys[i].address] < pending[keys[j].address]
+		})
+	}
+	return keys
+}
+
+func (p *TransactorPool) keysCopy() []key {
+	p.keysLock.Lock()
+	defer p.keysLock.Unlock()
+
+	return append(make([]key, 0, len(p.keys)), p.keys...)
+}
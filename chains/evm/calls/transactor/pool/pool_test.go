@@ -0,0 +1,171 @@
+package pool_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/pool"
+	mock_pool "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/pool/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransactorPoolTestSuite struct {
+	suite.Suite
+	mockBalanceClient *mock_pool.MockBalanceClient
+	mockRelayerSet    *mock_pool.MockRelayerSet
+	mockTransactor1   *mock_pool.MockKeyTransactor
+	mockTransactor2   *mock_pool.MockKeyTransactor
+	address1          common.Address
+	address2          common.Address
+}
+
+func TestRunTransactorPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactorPoolTestSuite))
+}
+
+func (s *TransactorPoolTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockBalanceClient = mock_pool.NewMockBalanceClient(gomockController)
+	s.mockRelayerSet = mock_pool.NewMockRelayerSet(gomockController)
+	s.mockTransactor1 = mock_pool.NewMockKeyTransactor(gomockController)
+	s.mockTransactor2 = mock_pool.NewMockKeyTransactor(gomockController)
+	s.address1 = common.HexToAddress("0x1")
+	s.address2 = common.HexToAddress("0x2")
+}
+
+func (s *TransactorPoolTestSuite) newPool(selection pool.Selection) *pool.TransactorPool {
+	p := pool.NewTransactorPool(s.mockBalanceClient, selection)
+	p.RegisterKey(s.address1, s.mockTransactor1)
+	p.RegisterKey(s.address2, s.mockTransactor2)
+	return p
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_NoKeys() {
+	p := pool.NewTransactorPool(s.mockBalanceClient, pool.SelectionRoundRobin)
+
+	_, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.ErrorIs(err, pool.ErrNoKeys)
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_RoundRobin() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	gomock.InOrder(
+		s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil),
+		s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil),
+		s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{3}, nil),
+	)
+
+	for _, expected := range []common.Hash{{1}, {2}, {3}} {
+		hash, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+		s.Nil(err)
+		s.Equal(expected, *hash)
+	}
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_LeastPending() {
+	p := s.newPool(pool.SelectionLeastPending)
+	s.mockTransactor1.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{{Nonce: 1}, {Nonce: 2}})
+	s.mockTransactor2.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{{Nonce: 1}})
+	s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil)
+
+	hash, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(common.Hash{2}, *hash)
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_FallsBackToNextKey() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{}, errors.New("insufficient funds"))
+	s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil)
+
+	hash, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(common.Hash{2}, *hash)
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_AllKeysFail() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{}, errors.New("insufficient funds"))
+	s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{}, errors.New("insufficient funds"))
+
+	_, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_AmbiguousErrorDoesNotFallBack() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{}, errors.New("context deadline exceeded"))
+
+	_, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_SkipsKeysThatAreNotRelayers() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	p.RegisterRelayerSet(s.mockRelayerSet)
+	s.mockRelayerSet.EXPECT().IsRelayer(s.address1).Return(false, nil)
+	s.mockRelayerSet.EXPECT().IsRelayer(s.address2).Return(true, nil)
+	s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil)
+
+	hash, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(common.Hash{2}, *hash)
+}
+
+func (s *TransactorPoolTestSuite) TestTransact_NoRelayerKeys() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	p.RegisterRelayerSet(s.mockRelayerSet)
+	s.mockRelayerSet.EXPECT().IsRelayer(gomock.Any()).Return(false, nil).Times(2)
+
+	_, err := p.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.ErrorIs(err, pool.ErrNoRelayerKeys)
+}
+
+func (s *TransactorPoolTestSuite) TestPendingTransactions() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	s.mockTransactor1.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{{From: s.address1, Nonce: 1}})
+	s.mockTransactor2.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{{From: s.address2, Nonce: 1}})
+
+	pending := p.PendingTransactions()
+
+	s.Equal([]monitored.PendingTransaction{{From: s.address1, Nonce: 1}, {From: s.address2, Nonce: 1}}, pending)
+}
+
+func (s *TransactorPoolTestSuite) TestKeys() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), s.address1, nil).Return(big.NewInt(100), nil)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), s.address2, nil).Return(big.NewInt(200), nil)
+	s.mockTransactor1.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{{Nonce: 1}})
+	s.mockTransactor2.EXPECT().PendingTransactions().Return([]monitored.PendingTransaction{})
+
+	keys, err := p.Keys(context.Background())
+
+	s.Nil(err)
+	s.Equal([]pool.KeyStatus{
+		{Address: s.address1, Balance: big.NewInt(100), Pending: 1},
+		{Address: s.address2, Balance: big.NewInt(200), Pending: 0},
+	}, keys)
+}
+
+func (s *TransactorPoolTestSuite) TestKeys_BalanceFails() {
+	p := s.newPool(pool.SelectionRoundRobin)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), s.address1, nil).Return(nil, errors.New("error"))
+
+	_, err := p.Keys(context.Background())
+
+	s.NotNil(err)
+}
@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
 	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ChainSafe/chainbridge-core/util"
 	"github.com/rs/zerolog/log"
 )
 
@@ -21,11 +25,26 @@ type ProposalExecutor interface {
 	Execute(message *message.Message) error
 }
 
+type MessageOutbox interface {
+	PendingMessages(source uint8) ([]*message.Message, error)
+	MarkDone(m *message.Message) error
+}
+
+type DeadLetterStorer interface {
+	StoreDeadLetter(m *message.Message, err error, attempts int) error
+}
+
 // EVMChain is struct that aggregates all data required for
 type EVMChain struct {
-	listener   EventListener
-	writer     ProposalExecutor
-	blockstore *store.BlockStore
+	listener    EventListener
+	writer      ProposalExecutor
+	blockstore  *store.BlockStore
+	outbox      MessageOutbox
+	deadLetters DeadLetterStorer
+	retryPolicy *retry.Policy
+	queue       *executionQueue
+	polling     atomic.Bool
+	running     sync.WaitGroup
 
 	domainID    uint8
 	startBlock  *big.Int
@@ -33,22 +52,50 @@ type EVMChain struct {
 	latestBlock bool
 }
 
-func NewEVMChain(listener EventListener, writer ProposalExecutor, blockstore *store.BlockStore, domainID uint8, startBlock *big.Int, latestBlock bool, freshStart bool) *EVMChain {
-	return &EVMChain{
+// NewEVMChain creates an EVMChain that executes up to executionConcurrency
+// messages at the same time. If orderedExecution is set, messages from the same
+// source are executed one at a time in deposit nonce order.
+//
+// Failed executions are retried according to retryPolicy and messages that
+// can't be executed are moved to deadLetters.
+func NewEVMChain(
+	listener EventListener,
+	writer ProposalExecutor,
+	blockstore *store.BlockStore,
+	outbox MessageOutbox,
+	deadLetters DeadLetterStorer,
+	retryPolicy *retry.Policy,
+	domainID uint8,
+	startBlock *big.Int,
+	latestBlock bool,
+	freshStart bool,
+	executionConcurrency int,
+	orderedExecution bool) *EVMChain {
+	chain := &EVMChain{
 		listener:    listener,
 		writer:      writer,
 		blockstore:  blockstore,
+		outbox:      outbox,
+		deadLetters: deadLetters,
+		retryPolicy: retryPolicy,
 		domainID:    domainID,
 		startBlock:  startBlock,
 		latestBlock: latestBlock,
 		freshStart:  freshStart,
 	}
+	chain.queue = newExecutionQueue(chain.execute, executionConcurrency, orderedExecution)
+	return chain
 }
 
 // PollEvents is the goroutine that polls blocks and searches Deposit events in them.
 // Events are then sent to eventsChan.
 func (c *EVMChain) PollEvents(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message) {
 	log.Info().Msg("Polling Blocks...")
+	c.running.Add(1)
+	go func() {
+		defer c.running.Done()
+		c.queue.run(ctx)
+	}()
 
 	startBlock, err := c.blockstore.GetStartBlock(
 		c.domainID,
@@ -61,22 +108,106 @@ func (c *EVMChain) PollEvents(ctx context.Context, sysErr chan<- error, msgChan
 		return
 	}
 
-	go c.listener.ListenToEvents(ctx, startBlock, msgChan, sysErr)
+	err = c.redeliverPendingMessages(ctx, msgChan)
+	if err != nil {
+		sysErr <- fmt.Errorf("error %w on redelivering pending messages", err)
+		return
+	}
+
+	c.polling.Store(true)
+	c.running.Add(1)
+	go func() {
+		defer c.running.Done()
+		defer c.polling.Store(false)
+		c.listener.ListenToEvents(ctx, startBlock, msgChan, sysErr)
+	}()
 }
 
-func (c *EVMChain) Write(msg []*message.Message) error {
-	for _, msg := range msg {
-		go func(msg *message.Message) {
-			err := c.writer.Execute(msg)
-			if err != nil {
-				log.Err(err).Msgf("Failed writing message %v", msg)
+// Wait blocks until the listener stopped and executions started before PollEvents
+// context was done finished, or until ctx is done. Messages that were not executed
+// stay in the outbox.
+func (c *EVMChain) Wait(ctx context.Context) error {
+	return util.Wait(ctx, &c.running)
+}
+
+// Polling reports whether the chain listener is running
+func (c *EVMChain) Polling() bool {
+	return c.polling.Load()
+}
+
+// redeliverPendingMessages sends messages from this chain that didn't reach a final
+// outcome before the relayer was stopped
+func (c *EVMChain) redeliverPendingMessages(ctx context.Context, msgChan chan []*message.Message) error {
+	msgs, err := c.outbox.PendingMessages(c.domainID)
+	if err != nil {
+		return err
+	}
+
+	domainMessages := make(map[uint8][]*message.Message)
+	for _, m := range msgs {
+		domainMessages[m.Destination] = append(domainMessages[m.Destination], m)
+	}
+
+	for destination, msgs := range domainMessages {
+		log.Info().Uint8("domainID", c.domainID).Msgf("Redelivering %d pending messages to destination %v", len(msgs), destination)
+		go func(msgs []*message.Message) {
+			select {
+			case msgChan <- msgs:
+			case <-ctx.Done():
 			}
-		}(msg)
+		}(msgs)
 	}
 
 	return nil
 }
 
+// Retract removes messages that are waiting to be executed from the execution queue
+func (c *EVMChain) Retract(msgs []*message.Message) {
+	c.queue.remove(msgs)
+}
+
+// Write adds messages to the execution queue. Outcome of every message
+// is sent to results once it is executed.
+func (c *EVMChain) Write(msgs []*message.Message, results chan<- *message.ExecutionResult) error {
+	return c.queue.push(msgs, results)
+}
+
+// execute executes message with retries. Executed messages stay in the outbox
+// until the destination reports the proposal as executed or cancelled, messages
+// that failed permanently or exhausted retries are moved to dead letters.
+func (c *EVMChain) execute(ctx context.Context, m *message.Message) error {
+	attempts, err := c.retryPolicy.Do(ctx, func() error {
+		err := c.writer.Execute(m)
+		if err != nil {
+			log.Warn().Err(err).Uint8("source", m.Source).Uint64("nonce", m.DepositNonce).Msgf("Failed executing message")
+		}
+		return err
+	})
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		// message stays in the outbox and is redelivered after restart
+		return err
+	}
+
+	log.Error().Err(err).Uint8("source", m.Source).Uint64("nonce", m.DepositNonce).Msgf("Moving message to dead letters after %d attempts", attempts)
+	dlErr := c.deadLetters.StoreDeadLetter(m, err, attempts)
+	if dlErr != nil {
+		log.Err(dlErr).Msgf("Failed storing dead letter %v", m)
+		return err
+	}
+	c.markDone(m)
+	return err
+}
+
+func (c *EVMChain) markDone(m *message.Message) {
+	err := c.outbox.MarkDone(m)
+	if err != nil {
+		log.Err(err).Msgf("Failed marking message %v as done", m)
+	}
+}
+
 func (c *EVMChain) DomainID() uint8 {
 	return c.domainID
 }
@@ -0,0 +1,345 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	mock_evm "github.com/ChainSafe/chainbridge-core/chains/evm/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type WriteTestSuite struct {
+	suite.Suite
+	mockWriter      *mock_evm.MockProposalExecutor
+	mockOutbox      *mock_evm.MockMessageOutbox
+	mockDeadLetters *mock_evm.MockDeadLetterStorer
+	retryPolicy     *retry.Policy
+	cancel          context.CancelFunc
+}
+
+func TestRunWriteTestSuite(t *testing.T) {
+	suite.Run(t, new(WriteTestSuite))
+}
+
+func (s *WriteTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockWriter = mock_evm.NewMockProposalExecutor(gomockController)
+	s.mockOutbox = mock_evm.NewMockMessageOutbox(gomockController)
+	s.mockDeadLetters = mock_evm.NewMockDeadLetterStorer(gomockController)
+	s.retryPolicy = retry.NewPolicy(0, time.Millisecond, time.Millisecond)
+}
+
+func (s *WriteTestSuite) TearDownTest() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func (s *WriteTestSuite) startChain(concurrency int, ordered bool) *EVMChain {
+	chain := NewEVMChain(nil, s.mockWriter, nil, s.mockOutbox, s.mockDeadLetters, s.retryPolicy, 2, big.NewInt(0), false, false, concurrency, ordered)
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go chain.queue.run(ctx)
+	return chain
+}
+
+func (s *WriteTestSuite) TestWrite_SendsExecutionResults() {
+	chain := s.startChain(2, false)
+	m1 := &message.Message{Source: 1, DepositNonce: 1}
+	m2 := &message.Message{Source: 1, DepositNonce: 2}
+	s.mockWriter.EXPECT().Execute(m1).Return(nil)
+	s.mockWriter.EXPECT().Execute(m2).Return(errors.New("error"))
+	s.mockDeadLetters.EXPECT().StoreDeadLetter(m2, gomock.Any(), 1).Return(nil)
+	s.mockOutbox.EXPECT().MarkDone(m2).Return(nil)
+
+	results := make(chan *message.ExecutionResult, 2)
+	err := chain.Write([]*message.Message{m1, m2}, results)
+	s.Nil(err)
+
+	outcome := make(map[uint64]error)
+	for i := 0; i < 2; i++ {
+		r := <-results
+		outcome[r.Message.DepositNonce] = r.Err
+	}
+	s.Nil(outcome[1])
+	s.NotNil(outcome[2])
+}
+
+func (s *WriteTestSuite) TestWrite_LimitsConcurrentExecutions() {
+	chain := s.startChain(2, false)
+	lock := sync.Mutex{}
+	running := 0
+	maxRunning := 0
+	s.mockWriter.EXPECT().Execute(gomock.Any()).DoAndReturn(func(m *message.Message) error {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+		time.Sleep(time.Millisecond * 5)
+		lock.Lock()
+		running--
+		lock.Unlock()
+		return nil
+	}).Times(6)
+
+	msgs := make([]*message.Message, 0)
+	for i := 1; i <= 6; i++ {
+		msgs = append(msgs, &message.Message{Source: uint8(i), DepositNonce: uint64(i)})
+	}
+	results := make(chan *message.ExecutionResult, 6)
+	err := chain.Write(msgs, results)
+	s.Nil(err)
+
+	for i := 0; i < 6; i++ {
+		<-results
+	}
+	s.Equal(2, maxRunning)
+}
+
+func (s *WriteTestSuite) TestWrite_OrderedExecutesSourceMessagesByNonce() {
+	chain := s.startChain(3, true)
+	executed := make(chan uint64, 3)
+	s.mockWriter.EXPECT().Execute(gomock.Any()).DoAndReturn(func(m *message.Message) error {
+		time.Sleep(time.Millisecond * 5)
+		executed <- m.DepositNonce
+		return nil
+	}).Times(3)
+
+	results := make(chan *message.ExecutionResult, 3)
+	err := chain.Write([]*message.Message{
+		{Source: 1, DepositNonce: 3},
+		{Source: 1, DepositNonce: 1},
+		{Source: 1, DepositNonce: 2},
+	}, results)
+	s.Nil(err)
+
+	for i := 0; i < 3; i++ {
+		<-results
+	}
+	s.Equal(uint64(1), <-executed)
+	s.Equal(uint64(2), <-executed)
+	s.Equal(uint64(3), <-executed)
+}
+
+func (s *WriteTestSuite) TestWrite_FailsPendingMessagesWhenStopped() {
+	chain := s.startChain(1, false)
+	s.mockWriter.EXPECT().Execute(gomock.Any()).DoAndReturn(func(m *message.Message) error {
+		s.cancel()
+		time.Sleep(time.Millisecond * 5)
+		return nil
+	})
+
+	results := make(chan *message.ExecutionResult, 2)
+	err := chain.Write([]*message.Message{
+		{Source: 1, DepositNonce: 1},
+		{Source: 1, DepositNonce: 2},
+	}, results)
+	s.Nil(err)
+
+	outcome := make(map[uint64]error)
+	for i := 0; i < 2; i++ {
+		r := <-results
+		outcome[r.Message.DepositNonce] = r.Err
+	}
+	s.Nil(outcome[1])
+	s.Equal(ErrQueueStopped, outcome[2])
+
+	err = chain.Write([]*message.Message{{Source: 1, DepositNonce: 3}}, results)
+	s.Equal(ErrQueueStopped, err)
+}
+
+func (s *WriteTestSuite) TestRetract_FailsRetractedPendingMessages() {
+	chain := s.startChain(1, false)
+	executing := make(chan struct{})
+	retracted := make(chan struct{})
+	m1 := &message.Message{Source: 1, DepositNonce: 1}
+	m2 := &message.Message{Source: 1, DepositNonce: 2}
+	m3 := &message.Message{Source: 1, DepositNonce: 3}
+	s.mockWriter.EXPECT().Execute(m1).DoAndReturn(func(m *message.Message) error {
+		close(executing)
+		<-retracted
+		return nil
+	})
+	s.mockWriter.EXPECT().Execute(m3).Return(nil)
+
+	results := make(chan *message.ExecutionResult, 3)
+	err := chain.Write([]*message.Message{m1, m2, m3}, results)
+	s.Nil(err)
+	<-executing
+	chain.Retract([]*message.Message{{Source: 1, DepositNonce: 2}})
+	close(retracted)
+
+	outcome := make(map[uint64]error)
+	for i := 0; i < 3; i++ {
+		r := <-results
+		outcome[r.Message.DepositNonce] = r.Err
+	}
+	s.Nil(outcome[1])
+	s.ErrorIs(outcome[2], message.ErrMessageRetracted)
+	s.Nil(outcome[3])
+}
+
+func (s *WriteTestSuite) TestWrite_SkipsMessagesAlreadyQueued() {
+	chain := s.startChain(1, false)
+	executing := make(chan struct{})
+	redelivered := make(chan struct{})
+	m1 := &message.Message{Source: 1, DepositNonce: 1}
+	m2 := &message.Message{Source: 1, DepositNonce: 2}
+	s.mockWriter.EXPECT().Execute(m1).DoAndReturn(func(m *message.Message) error {
+		close(executing)
+		<-redelivered
+		return nil
+	})
+	s.mockWriter.EXPECT().Execute(m2).Return(nil)
+
+	results := make(chan *message.ExecutionResult, 2)
+	err := chain.Write([]*message.Message{m1, m2}, results)
+	s.Nil(err)
+	<-executing
+	redeliveryResults := make(chan *message.ExecutionResult, 2)
+	err = chain.Write([]*message.Message{{Source: 1, DepositNonce: 1}, {Source: 1, DepositNonce: 2}}, redeliveryResults)
+	s.Nil(err)
+	close(redelivered)
+
+	for i := 0; i < 2; i++ {
+		s.ErrorIs((<-redeliveryResults).Err, message.ErrMessageAlreadyQueued)
+		s.Nil((<-results).Err)
+	}
+}
+
+func (s *WriteTestSuite) TestWrite_RetriesFailedExecution() {
+	s.retryPolicy = retry.NewPolicy(3, time.Millisecond, time.Millisecond)
+	chain := s.startChain(1, false)
+	m := &message.Message{Source: 1, DepositNonce: 1}
+	gomock.InOrder(
+		s.mockWriter.EXPECT().Execute(m).Return(errors.New("error")).Times(2),
+		s.mockWriter.EXPECT().Execute(m).Return(nil),
+	)
+
+	results := make(chan *message.ExecutionResult, 1)
+	err := chain.Write([]*message.Message{m}, results)
+	s.Nil(err)
+
+	s.Nil((<-results).Err)
+}
+
+func (s *WriteTestSuite) TestWrite_MovesExhaustedMessageToDeadLetters() {
+	s.retryPolicy = retry.NewPolicy(2, time.Millisecond, time.Millisecond)
+	chain := s.startChain(1, false)
+	m := &message.Message{Source: 1, DepositNonce: 1}
+	s.mockWriter.EXPECT().Execute(m).Return(errors.New("error")).Times(3)
+	s.mockDeadLetters.EXPECT().StoreDeadLetter(m, gomock.Any(), 3).Return(nil)
+	s.mockOutbox.EXPECT().MarkDone(m).Return(nil)
+
+	results := make(chan *message.ExecutionResult, 1)
+	err := chain.Write([]*message.Message{m}, results)
+	s.Nil(err)
+
+	s.NotNil((<-results).Err)
+}
+
+func (s *WriteTestSuite) TestWrite_PermanentErrorIsNotRetried() {
+	s.retryPolicy = retry.NewPolicy(5, time.Millisecond, time.Millisecond)
+	chain := s.startChain(1, false)
+	m := &message.Message{Source: 1, DepositNonce: 1}
+	s.mockWriter.EXPECT().Execute(m).Return(retry.Permanent(errors.New("error")))
+	s.mockDeadLetters.EXPECT().StoreDeadLetter(m, gomock.Any(), 1).Return(nil)
+	s.mockOutbox.EXPECT().MarkDone(m).Return(nil)
+
+	results := make(chan *message.ExecutionResult, 1)
+	err := chain.Write([]*message.Message{m}, results)
+	s.Nil(err)
+
+	s.NotNil((<-results).Err)
+}
+
+func (s *WriteTestSuite) TestWrite_KeepsMessageInOutboxWhenStoppedDuringRetry() {
+	s.retryPolicy = retry.NewPolicy(5, time.Second, time.Second)
+	chain := s.startChain(1, false)
+	m := &message.Message{Source: 1, DepositNonce: 1}
+	s.mockWriter.EXPECT().Execute(m).DoAndReturn(func(m *message.Message) error {
+		s.cancel()
+		return errors.New("error")
+	})
+
+	results := make(chan *message.ExecutionResult, 1)
+	err := chain.Write([]*message.Message{m}, results)
+	s.Nil(err)
+
+	s.NotNil((<-results).Err)
+}
+
+func (s *WriteTestSuite) TestWrite_ExecutesMessagesAfterRestart() {
+	chain := s.startChain(1, false)
+	s.cancel()
+	s.Eventually(func() bool {
+		return chain.Write([]*message.Message{{Source: 1, DepositNonce: 1}}, make(chan *message.ExecutionResult, 1)) == ErrQueueStopped
+	}, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go chain.queue.run(ctx)
+	m := &message.Message{Source: 1, DepositNonce: 2}
+	s.mockWriter.EXPECT().Execute(m).Return(nil)
+
+	results := make(chan *message.ExecutionResult, 1)
+	s.Eventually(func() bool {
+		return chain.Write([]*message.Message{m}, results) == nil
+	}, time.Second, time.Millisecond)
+
+	s.Nil((<-results).Err)
+}
+
+func (s *WriteTestSuite) TestWait_WaitsForExecutionInProgress() {
+	chain := NewEVMChain(nil, s.mockWriter, nil, s.mockOutbox, s.mockDeadLetters, s.retryPolicy, 2, big.NewInt(0), false, false, 1, false)
+	ctx, cancel := context.WithCancel(context.Background())
+	chain.running.Add(1)
+	go func() {
+		defer chain.running.Done()
+		chain.queue.run(ctx)
+	}()
+	m := &message.Message{Source: 1, DepositNonce: 1}
+	executing := make(chan struct{})
+	s.mockWriter.EXPECT().Execute(m).DoAndReturn(func(m *message.Message) error {
+		close(executing)
+		time.Sleep(time.Millisecond * 20)
+		return nil
+	})
+	results := make(chan *message.ExecutionResult, 1)
+	s.Nil(chain.Write([]*message.Message{m}, results))
+	<-executing
+	cancel()
+
+	err := chain.Wait(context.Background())
+
+	s.Nil(err)
+	s.Len(results, 1)
+}
+
+func (s *WriteTestSuite) TestRedeliverPendingMessages_StopsWhenContextDone() {
+	chain := NewEVMChain(nil, s.mockWriter, nil, s.mockOutbox, s.mockDeadLetters, s.retryPolicy, 2, big.NewInt(0), false, false, 1, false)
+	m := &message.Message{Source: 2, Destination: 1, DepositNonce: 1}
+	s.mockOutbox.EXPECT().PendingMessages(uint8(2)).Return([]*message.Message{m}, nil)
+	msgChan := make(chan []*message.Message)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := chain.redeliverPendingMessages(ctx, msgChan)
+	s.Nil(err)
+	cancel()
+	time.Sleep(time.Millisecond * 20)
+
+	select {
+	case <-msgChan:
+		s.Fail("messages sent after context was done")
+	case <-time.After(time.Millisecond * 20):
+	}
+}
@@ -2,12 +2,12 @@ package executor
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
 	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
@@ -34,6 +34,8 @@ type EVMMessageHandler struct {
 	handlers       map[common.Address]MessageHandlerFunc
 }
 
+// HandleMessage converts message into a proposal. Errors that retrying
+// can't fix, like unknown handler or malformed payload, are marked as permanent.
 func (mh *EVMMessageHandler) HandleMessage(m *message.Message) (*proposal.Proposal, error) {
 	// Matching resource ID with handler.
 	addr, err := mh.handlerMatcher.GetHandlerAddressForResourceID(m.ResourceId)
@@ -43,12 +45,12 @@ func (mh *EVMMessageHandler) HandleMessage(m *message.Message) (*proposal.Propos
 	// Based on handler that registered on BridgeContract
 	handleMessage, err := mh.MatchAddressWithHandlerFunc(addr)
 	if err != nil {
-		return nil, err
+		return nil, retry.Permanent(err)
 	}
 	log.Info().Str("type", string(m.Type)).Uint8("src", m.Source).Uint8("dst", m.Destination).Uint64("nonce", m.DepositNonce).Str("resourceID", fmt.Sprintf("%x", m.ResourceId)).Msg("Handling new message")
 	prop, err := handleMessage(m, addr, *mh.handlerMatcher.ContractAddress())
 	if err != nil {
-		return nil, err
+		return nil, retry.Permanent(err)
 	}
 	return prop, nil
 }
@@ -76,64 +78,42 @@ func (mh *EVMMessageHandler) RegisterMessageHandler(address string, handler Mess
 }
 
 func ERC20MessageHandler(m *message.Message, handlerAddr, bridgeAddress common.Address) (*proposal.Proposal, error) {
-	if len(m.Payload) != 2 {
-		return nil, errors.New("malformed payload. Len  of payload should be 2")
-	}
-	amount, ok := m.Payload[0].([]byte)
-	if !ok {
-		return nil, errors.New("wrong payload amount format")
-	}
-	recipient, ok := m.Payload[1].([]byte)
-	if !ok {
-		return nil, errors.New("wrong payload recipient format")
+	payload, err := m.FungiblePayload()
+	if err != nil {
+		return nil, err
 	}
 	var data []byte
-	data = append(data, common.LeftPadBytes(amount, 32)...) // amount (uint256)
-	recipientLen := big.NewInt(int64(len(recipient))).Bytes()
+	data = append(data, common.LeftPadBytes(payload.Amount.Bytes(), 32)...) // amount (uint256)
+	recipientLen := big.NewInt(int64(len(payload.Recipient))).Bytes()
 	data = append(data, common.LeftPadBytes(recipientLen, 32)...) // length of recipient (uint256)
-	data = append(data, recipient...)                             // recipient ([]byte)
+	data = append(data, payload.Recipient...)                     // recipient ([]byte)
 	return proposal.NewProposal(m.Source, m.Destination, m.DepositNonce, m.ResourceId, data, handlerAddr, bridgeAddress, m.Metadata), nil
 }
 
 func ERC721MessageHandler(msg *message.Message, handlerAddr, bridgeAddress common.Address) (*proposal.Proposal, error) {
-
-	if len(msg.Payload) != 3 {
-		return nil, errors.New("malformed payload. Len  of payload should be 3")
-	}
-	tokenID, ok := msg.Payload[0].([]byte)
-	if !ok {
-		return nil, errors.New("wrong payload tokenID format")
-	}
-	recipient, ok := msg.Payload[1].([]byte)
-	if !ok {
-		return nil, errors.New("wrong payload recipient format")
-	}
-	metadata, ok := msg.Payload[2].([]byte)
-	if !ok {
-		return nil, errors.New("wrong payload metadata format")
+	payload, err := msg.NonFungiblePayload()
+	if err != nil {
+		return nil, err
 	}
 	data := bytes.Buffer{}
-	data.Write(common.LeftPadBytes(tokenID, 32))
-	recipientLen := big.NewInt(int64(len(recipient))).Bytes()
+	data.Write(common.LeftPadBytes(payload.TokenID.Bytes(), 32))
+	recipientLen := big.NewInt(int64(len(payload.Recipient))).Bytes()
 	data.Write(common.LeftPadBytes(recipientLen, 32))
-	data.Write(recipient)
-	metadataLen := big.NewInt(int64(len(metadata))).Bytes()
+	data.Write(payload.Recipient)
+	metadataLen := big.NewInt(int64(len(payload.Metadata))).Bytes()
 	data.Write(common.LeftPadBytes(metadataLen, 32))
-	data.Write(metadata)
+	data.Write(payload.Metadata)
 	return proposal.NewProposal(msg.Source, msg.Destination, msg.DepositNonce, msg.ResourceId, data.Bytes(), handlerAddr, bridgeAddress, msg.Metadata), nil
 }
 
 func GenericMessageHandler(msg *message.Message, handlerAddr, bridgeAddress common.Address) (*proposal.Proposal, error) {
-	if len(msg.Payload) != 1 {
-		return nil, errors.New("malformed payload. Len  of payload should be 1")
-	}
-	metadata, ok := msg.Payload[0].([]byte)
-	if !ok {
-		return nil, errors.New("wrong payload metadata format")
+	payload, err := msg.GenericPayload()
+	if err != nil {
+		return nil, err
 	}
 	data := bytes.Buffer{}
-	metadataLen := big.NewInt(int64(len(metadata))).Bytes()
+	metadataLen := big.NewInt(int64(len(payload.Metadata))).Bytes()
 	data.Write(common.LeftPadBytes(metadataLen, 32)) // length of metadata (uint256)
-	data.Write(metadata)
+	data.Write(payload.Metadata)
 	return proposal.NewProposal(msg.Source, msg.Destination, msg.DepositNonce, msg.ResourceId, data.Bytes(), handlerAddr, bridgeAddress, msg.Metadata), nil
 }
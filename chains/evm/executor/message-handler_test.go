@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/executor/mock"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -330,3 +333,50 @@ func (s *GenericHandlerTestSuite) TestGenericHandleEventIncorrectMetadata() {
 	s.NotNil(err)
 	s.EqualError(err, errIncorrectMetadata.Error())
 }
+
+type EVMMessageHandlerTestSuite struct {
+	suite.Suite
+	messageHandler     *executor.EVMMessageHandler
+	mockHandlerMatcher *mock_voter.MockHandlerMatcher
+}
+
+func TestRunEVMMessageHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(EVMMessageHandlerTestSuite))
+}
+
+func (s *EVMMessageHandlerTestSuite) SetupTest() {
+	s.mockHandlerMatcher = mock_voter.NewMockHandlerMatcher(gomock.NewController(s.T()))
+	s.messageHandler = executor.NewEVMMessageHandler(s.mockHandlerMatcher)
+}
+
+func (s *EVMMessageHandlerTestSuite) TestHandleMessage_HandlerLookupErrorIsNotPermanent() {
+	s.mockHandlerMatcher.EXPECT().GetHandlerAddressForResourceID(gomock.Any()).Return(common.Address{}, errors.New("connection refused"))
+
+	prop, err := s.messageHandler.HandleMessage(&message.Message{})
+
+	s.Nil(prop)
+	s.NotNil(err)
+	s.False(retry.IsPermanent(err))
+}
+
+func (s *EVMMessageHandlerTestSuite) TestHandleMessage_UnknownHandlerIsPermanent() {
+	s.mockHandlerMatcher.EXPECT().GetHandlerAddressForResourceID(gomock.Any()).Return(common.HexToAddress("0x4CEEf6139f00F9F4535Ad19640Ff7A0137708485"), nil)
+
+	prop, err := s.messageHandler.HandleMessage(&message.Message{})
+
+	s.Nil(prop)
+	s.True(retry.IsPermanent(err))
+}
+
+func (s *EVMMessageHandlerTestSuite) TestHandleMessage_MalformedPayloadIsPermanent() {
+	handlerAddress := common.HexToAddress("0x4CEEf6139f00F9F4535Ad19640Ff7A0137708485")
+	bridgeAddress := common.HexToAddress("0xf1e58fb17704c2da8479a533f9fad4ad0993ca6b")
+	s.messageHandler.RegisterMessageHandler(handlerAddress.Hex(), executor.ERC20MessageHandler)
+	s.mockHandlerMatcher.EXPECT().GetHandlerAddressForResourceID(gomock.Any()).Return(handlerAddress, nil)
+	s.mockHandlerMatcher.EXPECT().ContractAddress().Return(&bridgeAddress)
+
+	prop, err := s.messageHandler.HandleMessage(&message.Message{Payload: []interface{}{}})
+
+	s.Nil(prop)
+	s.True(retry.IsPermanent(err))
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ChainSafe/chainbridge-core/chains/evm/executor (interfaces: ChainClient,MessageHandler,BridgeContract)
+// Source: github.com/ChainSafe/chainbridge-core/chains/evm/executor (interfaces: ChainClient,MessageHandler,BridgeContract,RelayerSet,VoteTracker,ProposalStatusTracker,MessageSubmitter,HandlerMatcher)
 
 // Package mock_executor is a generated GoMock package.
 package mock_executor
@@ -13,8 +13,9 @@ import (
 	transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	proposal "github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
 	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	types "github.com/ChainSafe/chainbridge-core/types"
 	common "github.com/ethereum/go-ethereum/common"
-	types "github.com/ethereum/go-ethereum/core/types"
+	types0 "github.com/ethereum/go-ethereum/core/types"
 	rpc "github.com/ethereum/go-ethereum/rpc"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -87,10 +88,10 @@ func (mr *MockChainClientMockRecorder) From() *gomock.Call {
 }
 
 // GetTransactionByHash mocks base method.
-func (m *MockChainClient) GetTransactionByHash(arg0 common.Hash) (*types.Transaction, bool, error) {
+func (m *MockChainClient) GetTransactionByHash(arg0 common.Hash) (*types0.Transaction, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTransactionByHash", arg0)
-	ret0, _ := ret[0].(*types.Transaction)
+	ret0, _ := ret[0].(*types0.Transaction)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -159,10 +160,10 @@ func (mr *MockChainClientMockRecorder) SubscribePendingTransactions(arg0, arg1 i
 }
 
 // TransactionByHash mocks base method.
-func (m *MockChainClient) TransactionByHash(arg0 context.Context, arg1 common.Hash) (*types.Transaction, bool, error) {
+func (m *MockChainClient) TransactionByHash(arg0 context.Context, arg1 common.Hash) (*types0.Transaction, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TransactionByHash", arg0, arg1)
-	ret0, _ := ret[0].(*types.Transaction)
+	ret0, _ := ret[0].(*types0.Transaction)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -175,10 +176,10 @@ func (mr *MockChainClientMockRecorder) TransactionByHash(arg0, arg1 interface{})
 }
 
 // TransactionReceipt mocks base method.
-func (m *MockChainClient) TransactionReceipt(arg0 context.Context, arg1 common.Hash) (*types.Receipt, error) {
+func (m *MockChainClient) TransactionReceipt(arg0 context.Context, arg1 common.Hash) (*types0.Receipt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TransactionReceipt", arg0, arg1)
-	ret0, _ := ret[0].(*types.Receipt)
+	ret0, _ := ret[0].(*types0.Receipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -231,10 +232,10 @@ func (mr *MockChainClientMockRecorder) UnsafeNonce() *gomock.Call {
 }
 
 // WaitAndReturnTxReceipt mocks base method.
-func (m *MockChainClient) WaitAndReturnTxReceipt(arg0 common.Hash) (*types.Receipt, error) {
+func (m *MockChainClient) WaitAndReturnTxReceipt(arg0 common.Hash) (*types0.Receipt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WaitAndReturnTxReceipt", arg0)
-	ret0, _ := ret[0].(*types.Receipt)
+	ret0, _ := ret[0].(*types0.Receipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -306,21 +307,6 @@ func (m *MockBridgeContract) EXPECT() *MockBridgeContractMockRecorder {
 	return m.recorder
 }
 
-// GetThreshold mocks base method.
-func (m *MockBridgeContract) GetThreshold() (byte, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetThreshold")
-	ret0, _ := ret[0].(byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetThreshold indicates an expected call of GetThreshold.
-func (mr *MockBridgeContractMockRecorder) GetThreshold() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreshold", reflect.TypeOf((*MockBridgeContract)(nil).GetThreshold))
-}
-
 // IsProposalVotedBy mocks base method.
 func (m *MockBridgeContract) IsProposalVotedBy(arg0 common.Address, arg1 *proposal.Proposal) (bool, error) {
 	m.ctrl.T.Helper()
@@ -379,3 +365,215 @@ func (mr *MockBridgeContractMockRecorder) VoteProposal(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteProposal", reflect.TypeOf((*MockBridgeContract)(nil).VoteProposal), arg0, arg1)
 }
+
+// MockRelayerSet is a mock of RelayerSet interface.
+type MockRelayerSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerSetMockRecorder
+}
+
+// MockRelayerSetMockRecorder is the mock recorder for MockRelayerSet.
+type MockRelayerSetMockRecorder struct {
+	mock *MockRelayerSet
+}
+
+// NewMockRelayerSet creates a new mock instance.
+func NewMockRelayerSet(ctrl *gomock.Controller) *MockRelayerSet {
+	mock := &MockRelayerSet{ctrl: ctrl}
+	mock.recorder = &MockRelayerSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerSet) EXPECT() *MockRelayerSetMockRecorder {
+	return m.recorder
+}
+
+// GetThreshold mocks base method.
+func (m *MockRelayerSet) GetThreshold() (byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreshold")
+	ret0, _ := ret[0].(byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThreshold indicates an expected call of GetThreshold.
+func (mr *MockRelayerSetMockRecorder) GetThreshold() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreshold", reflect.TypeOf((*MockRelayerSet)(nil).GetThreshold))
+}
+
+// IsRelayer mocks base method.
+func (m *MockRelayerSet) IsRelayer(arg0 common.Address) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRelayer", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRelayer indicates an expected call of IsRelayer.
+func (mr *MockRelayerSetMockRecorder) IsRelayer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRelayer", reflect.TypeOf((*MockRelayerSet)(nil).IsRelayer), arg0)
+}
+
+// MockVoteTracker is a mock of VoteTracker interface.
+type MockVoteTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoteTrackerMockRecorder
+}
+
+// MockVoteTrackerMockRecorder is the mock recorder for MockVoteTracker.
+type MockVoteTrackerMockRecorder struct {
+	mock *MockVoteTracker
+}
+
+// NewMockVoteTracker creates a new mock instance.
+func NewMockVoteTracker(ctrl *gomock.Controller) *MockVoteTracker {
+	mock := &MockVoteTracker{ctrl: ctrl}
+	mock.recorder = &MockVoteTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoteTracker) EXPECT() *MockVoteTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackVote mocks base method.
+func (m *MockVoteTracker) TrackVote(arg0, arg1 byte, arg2 uint64, arg3 common.Hash) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackVote", arg0, arg1, arg2, arg3)
+}
+
+// TrackVote indicates an expected call of TrackVote.
+func (mr *MockVoteTrackerMockRecorder) TrackVote(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackVote", reflect.TypeOf((*MockVoteTracker)(nil).TrackVote), arg0, arg1, arg2, arg3)
+}
+
+// MockProposalStatusTracker is a mock of ProposalStatusTracker interface.
+type MockProposalStatusTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockProposalStatusTrackerMockRecorder
+}
+
+// MockProposalStatusTrackerMockRecorder is the mock recorder for MockProposalStatusTracker.
+type MockProposalStatusTrackerMockRecorder struct {
+	mock *MockProposalStatusTracker
+}
+
+// NewMockProposalStatusTracker creates a new mock instance.
+func NewMockProposalStatusTracker(ctrl *gomock.Controller) *MockProposalStatusTracker {
+	mock := &MockProposalStatusTracker{ctrl: ctrl}
+	mock.recorder = &MockProposalStatusTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProposalStatusTracker) EXPECT() *MockProposalStatusTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackProposalStatus mocks base method.
+func (m *MockProposalStatusTracker) TrackProposalStatus(arg0, arg1 byte, arg2 uint64, arg3 byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackProposalStatus", arg0, arg1, arg2, arg3)
+}
+
+// TrackProposalStatus indicates an expected call of TrackProposalStatus.
+func (mr *MockProposalStatusTrackerMockRecorder) TrackProposalStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackProposalStatus", reflect.TypeOf((*MockProposalStatusTracker)(nil).TrackProposalStatus), arg0, arg1, arg2, arg3)
+}
+
+// MockMessageSubmitter is a mock of MessageSubmitter interface.
+type MockMessageSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageSubmitterMockRecorder
+}
+
+// MockMessageSubmitterMockRecorder is the mock recorder for MockMessageSubmitter.
+type MockMessageSubmitterMockRecorder struct {
+	mock *MockMessageSubmitter
+}
+
+// NewMockMessageSubmitter creates a new mock instance.
+func NewMockMessageSubmitter(ctrl *gomock.Controller) *MockMessageSubmitter {
+	mock := &MockMessageSubmitter{ctrl: ctrl}
+	mock.recorder = &MockMessageSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageSubmitter) EXPECT() *MockMessageSubmitterMockRecorder {
+	return m.recorder
+}
+
+// Submit mocks base method.
+func (m *MockMessageSubmitter) Submit(arg0 context.Context, arg1 []*message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockMessageSubmitterMockRecorder) Submit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockMessageSubmitter)(nil).Submit), arg0, arg1)
+}
+
+// MockHandlerMatcher is a mock of HandlerMatcher interface.
+type MockHandlerMatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandlerMatcherMockRecorder
+}
+
+// MockHandlerMatcherMockRecorder is the mock recorder for MockHandlerMatcher.
+type MockHandlerMatcherMockRecorder struct {
+	mock *MockHandlerMatcher
+}
+
+// NewMockHandlerMatcher creates a new mock instance.
+func NewMockHandlerMatcher(ctrl *gomock.Controller) *MockHandlerMatcher {
+	mock := &MockHandlerMatcher{ctrl: ctrl}
+	mock.recorder = &MockHandlerMatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandlerMatcher) EXPECT() *MockHandlerMatcherMockRecorder {
+	return m.recorder
+}
+
+// ContractAddress mocks base method.
+func (m *MockHandlerMatcher) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockHandlerMatcherMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockHandlerMatcher)(nil).ContractAddress))
+}
+
+// GetHandlerAddressForResourceID mocks base method.
+func (m *MockHandlerMatcher) GetHandlerAddressForResourceID(arg0 types.ResourceID) (common.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHandlerAddressForResourceID", arg0)
+	ret0, _ := ret[0].(common.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHandlerAddressForResourceID indicates an expected call of GetHandlerAddressForResourceID.
+func (mr *MockHandlerMatcherMockRecorder) GetHandlerAddressForResourceID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHandlerAddressForResourceID", reflect.TypeOf((*MockHandlerMatcher)(nil).GetHandlerAddressForResourceID), arg0)
+}
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"math/rand"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
@@ -28,6 +30,10 @@ const (
 	maxSimulateVoteChecks = 5
 	maxShouldVoteChecks   = 40
 	shouldVoteCheckPeriod = 15
+	// sentVoteRetention is how long messages of sent votes are kept to be
+	// resubmitted if the vote times out. Has to be longer than the
+	// transaction timeout.
+	sentVoteRetention = time.Hour * 6
 )
 
 var (
@@ -51,14 +57,44 @@ type BridgeContract interface {
 	VoteProposal(proposal *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error)
 	SimulateVoteProposal(proposal *proposal.Proposal) error
 	ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error)
+}
+
+type RelayerSet interface {
 	GetThreshold() (uint8, error)
+	IsRelayer(relayerAddress common.Address) (bool, error)
+}
+
+type VoteTracker interface {
+	TrackVote(source uint8, destination uint8, depositNonce uint64, txHash common.Hash)
+}
+
+type ProposalStatusTracker interface {
+	TrackProposalStatus(source uint8, destination uint8, depositNonce uint64, status uint8)
+}
+
+type MessageSubmitter interface {
+	Submit(ctx context.Context, msgs []*message.Message) error
+}
+
+type sentVote struct {
+	message *message.Message
+	sentAt  time.Time
 }
 
 type EVMVoter struct {
 	mh                   MessageHandler
 	client               ChainClient
 	bridgeContract       BridgeContract
+	relayerSet           RelayerSet
 	pendingProposalVotes map[common.Hash]uint8
+	trackers             []VoteTracker
+	statusTrackers       []ProposalStatusTracker
+	// relayerAddresses are additional keys the relayer votes with
+	relayerAddresses []common.Address
+
+	submitter     MessageSubmitter
+	sentVotes     map[common.Hash]sentVote
+	sentVotesLock sync.Mutex
 }
 
 // NewVoterWithSubscription creates an instance of EVMVoter that votes for
@@ -68,12 +104,14 @@ type EVMVoter struct {
 // pending voteProposal transactions and avoids wasting gas on sending votes
 // for transactions that will fail.
 // Currently, officially supported only by Geth nodes.
-func NewVoterWithSubscription(mh MessageHandler, client ChainClient, bridgeContract BridgeContract) (*EVMVoter, error) {
+func NewVoterWithSubscription(mh MessageHandler, client ChainClient, bridgeContract BridgeContract, relayerSet RelayerSet) (*EVMVoter, error) {
 	voter := &EVMVoter{
 		mh:                   mh,
 		client:               client,
 		bridgeContract:       bridgeContract,
+		relayerSet:           relayerSet,
 		pendingProposalVotes: make(map[common.Hash]uint8),
+		sentVotes:            make(map[common.Hash]sentVote),
 	}
 
 	ch := make(chan common.Hash)
@@ -91,24 +129,81 @@ func NewVoterWithSubscription(mh MessageHandler, client ChainClient, bridgeContr
 // It is created without pending proposal subscription and is a fallback
 // for nodes that don't support pending transaction subscription and will vote
 // on proposals that already satisfy threshold.
-func NewVoter(mh MessageHandler, client ChainClient, bridgeContract BridgeContract) *EVMVoter {
+func NewVoter(mh MessageHandler, client ChainClient, bridgeContract BridgeContract, relayerSet RelayerSet) *EVMVoter {
 	return &EVMVoter{
 		mh:                   mh,
 		client:               client,
 		bridgeContract:       bridgeContract,
+		relayerSet:           relayerSet,
 		pendingProposalVotes: make(map[common.Hash]uint8),
+		sentVotes:            make(map[common.Hash]sentVote),
 	}
 }
 
+// RegisterTracker registers tracker notified of every vote sent by the relayer
+func (v *EVMVoter) RegisterTracker(tracker VoteTracker) {
+	v.trackers = append(v.trackers, tracker)
+}
+
+// RegisterRelayerAddress registers additional key the relayer votes with
+// so the relayer doesn't vote again for proposals already voted with it
+func (v *EVMVoter) RegisterRelayerAddress(address common.Address) {
+	v.relayerAddresses = append(v.relayerAddresses, address)
+}
+
+// RegisterStatusTracker registers tracker notified when the voter finds
+// proposal already executed or cancelled on chain
+func (v *EVMVoter) RegisterStatusTracker(tracker ProposalStatusTracker) {
+	v.statusTrackers = append(v.statusTrackers, tracker)
+}
+
+// RegisterSubmitter enables resubmitting messages whose vote transaction timed out
+func (v *EVMVoter) RegisterSubmitter(submitter MessageSubmitter) {
+	v.submitter = submitter
+}
+
+// HandleTimeout resubmits message whose vote transaction timed out so
+// the relayer votes for it again
+func (v *EVMVoter) HandleTimeout(hash common.Hash) {
+	v.sentVotesLock.Lock()
+	vote, ok := v.sentVotes[hash]
+	delete(v.sentVotes, hash)
+	v.sentVotesLock.Unlock()
+	if !ok || v.submitter == nil {
+		return
+	}
+
+	m := vote.message
+	log.Warn().Uint8("source", m.Source).Uint64("nonce", m.DepositNonce).Msgf("Vote %s timed out, resubmitting message", hash)
+	go func() {
+		err := v.submitter.Submit(context.Background(), []*message.Message{m})
+		if err != nil {
+			log.Error().Err(err).Uint8("source", m.Source).Uint64("nonce", m.DepositNonce).Msgf("Failed resubmitting message")
+		}
+	}()
+}
+
 // Execute checks if relayer already voted and is threshold
 // satisfied and casts a vote if it isn't.
+// Relayer doesn't vote if all its keys were removed from bridge relayers.
 func (v *EVMVoter) Execute(m *message.Message) error {
 	prop, err := v.mh.HandleMessage(m)
 	if err != nil {
+		log.Error().Err(err).Uint8("source", m.Source).Uint64("nonce", m.DepositNonce).Msgf("Failed handling message")
+		return err
+	}
+
+	relayerAddress := v.client.RelayerAddress()
+	isRelayer, err := v.isRelayer(relayerAddress)
+	if err != nil {
+		log.Error().Err(err).Msgf("Checking if %s is a relayer failed", relayerAddress)
 		return err
 	}
+	if !isRelayer {
+		return retry.Permanent(fmt.Errorf("none of the keys of %s is a relayer, refusing to vote for proposal %+v", relayerAddress, prop))
+	}
 
-	votedByTheRelayer, err := v.bridgeContract.IsProposalVotedBy(v.client.RelayerAddress(), prop)
+	votedByTheRelayer, err := v.isProposalVotedByRelayer(relayerAddress, prop)
 	if err != nil {
 		log.Error().Err(err).Msgf("Fetching is proposal %v voted by relayer failed", prop)
 		return err
@@ -140,9 +235,53 @@ func (v *EVMVoter) Execute(m *message.Message) error {
 	}
 
 	log.Debug().Str("hash", hash.String()).Uint64("nonce", prop.DepositNonce).Msgf("Voted")
+	v.storeSentVote(*hash, m)
+	for _, tracker := range v.trackers {
+		tracker.TrackVote(m.Source, m.Destination, m.DepositNonce, *hash)
+	}
 	return nil
 }
 
+// isRelayer checks if any of the relayer keys is allowed to vote
+func (v *EVMVoter) isRelayer(relayerAddress common.Address) (bool, error) {
+	for _, address := range append([]common.Address{relayerAddress}, v.relayerAddresses...) {
+		isRelayer, err := v.relayerSet.IsRelayer(address)
+		if err != nil || isRelayer {
+			return isRelayer, err
+		}
+	}
+	return false, nil
+}
+
+// isProposalVotedByRelayer checks if proposal was voted with any of the relayer keys
+func (v *EVMVoter) isProposalVotedByRelayer(relayerAddress common.Address, prop *proposal.Proposal) (bool, error) {
+	for _, address := range append([]common.Address{relayerAddress}, v.relayerAddresses...) {
+		voted, err := v.bridgeContract.IsProposalVotedBy(address, prop)
+		if err != nil || voted {
+			return voted, err
+		}
+	}
+	return false, nil
+}
+
+// storeSentVote keeps message of the vote until it is resubmitted or
+// sentVoteRetention passes
+func (v *EVMVoter) storeSentVote(hash common.Hash, m *message.Message) {
+	if v.submitter == nil {
+		return
+	}
+
+	v.sentVotesLock.Lock()
+	defer v.sentVotesLock.Unlock()
+
+	for h, vote := range v.sentVotes {
+		if time.Since(vote.sentAt) > sentVoteRetention {
+			delete(v.sentVotes, h)
+		}
+	}
+	v.sentVotes[hash] = sentVote{message: m, sentAt: time.Now()}
+}
+
 // shouldVoteForProposal checks if proposal already has threshold with pending
 // proposal votes from other relayers.
 // Only works properly in conjuction with NewVoterWithSubscription as without a subscription
@@ -161,10 +300,13 @@ func (v *EVMVoter) shouldVoteForProposal(prop *proposal.Proposal, tries int) (bo
 	}
 
 	if ps.Status == message.ProposalStatusExecuted || ps.Status == message.ProposalStatusCanceled {
+		for _, tracker := range v.statusTrackers {
+			tracker.TrackProposalStatus(prop.Source, prop.Destination, prop.DepositNonce, ps.Status)
+		}
 		return false, nil
 	}
 
-	threshold, err := v.bridgeContract.GetThreshold()
+	threshold, err := v.relayerSet.GetThreshold()
 	if err != nil {
 		return false, err
 	}
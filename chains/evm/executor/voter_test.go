@@ -1,6 +1,7 @@
 package executor_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/executor/mock"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -20,6 +22,7 @@ type VoterTestSuite struct {
 	mockMessageHandler *mock_voter.MockMessageHandler
 	mockClient         *mock_voter.MockChainClient
 	mockBridgeContract *mock_voter.MockBridgeContract
+	mockRelayerSet     *mock_voter.MockRelayerSet
 }
 
 func TestRunVoterTestSuite(t *testing.T) {
@@ -33,10 +36,12 @@ func (s *VoterTestSuite) SetupTest() {
 	s.mockMessageHandler = mock_voter.NewMockMessageHandler(gomockController)
 	s.mockClient = mock_voter.NewMockChainClient(gomockController)
 	s.mockBridgeContract = mock_voter.NewMockBridgeContract(gomockController)
+	s.mockRelayerSet = mock_voter.NewMockRelayerSet(gomockController)
 	s.voter = executor.NewVoter(
 		s.mockMessageHandler,
 		s.mockClient,
 		s.mockBridgeContract,
+		s.mockRelayerSet,
 	)
 	executor.Sleep = func(d time.Duration) {}
 }
@@ -50,16 +55,34 @@ func (s *VoterTestSuite) TestExecute_HandleMessageError() {
 	s.NotNil(err)
 }
 
+func (s *VoterTestSuite) TestExecute_TransientHandleMessageErrorIsRetried() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(nil, errors.New("connection refused"))
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.NotNil(err)
+	s.False(retry.IsPermanent(err))
+}
+
+func (s *VoterTestSuite) TestExecute_PermanentHandleMessageErrorIsNotRetried() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(nil, retry.Permanent(errors.New("malformed payload")))
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.True(retry.IsPermanent(err))
+}
+
 func (s *VoterTestSuite) TestExecute_SimulateVoteProposalError() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
 	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
 
 	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
 	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
-	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockRelayerSet.EXPECT().GetThreshold().Return(uint8(1), nil)
 	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(6).Return(errors.New("error"))
 
 	err := s.voter.Execute(&message.Message{})
@@ -73,10 +96,11 @@ func (s *VoterTestSuite) TestExecute_SimulateVoteProposal() {
 		DepositNonce: 0,
 	}, nil)
 	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
 
 	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
 	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
-	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockRelayerSet.EXPECT().GetThreshold().Return(uint8(1), nil)
 	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(1).Return(nil)
 	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{}, nil)
 
@@ -85,12 +109,69 @@ func (s *VoterTestSuite) TestExecute_SimulateVoteProposal() {
 	s.Nil(err)
 }
 
+func (s *VoterTestSuite) TestExecute_TracksVote() {
+	mockVoteTracker := mock_voter.NewMockVoteTracker(gomock.NewController(s.T()))
+	s.voter.RegisterTracker(mockVoteTracker)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       1,
+		DepositNonce: 3,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockRelayerSet.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	mockVoteTracker.EXPECT().TrackVote(uint8(1), uint8(2), uint64(3), common.Hash{1})
+
+	err := s.voter.Execute(&message.Message{Source: 1, Destination: 2, DepositNonce: 3})
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestHandleTimeout_ResubmitsMessage() {
+	mockSubmitter := mock_voter.NewMockMessageSubmitter(gomock.NewController(s.T()))
+	s.voter.RegisterSubmitter(mockSubmitter)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       1,
+		DepositNonce: 3,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockRelayerSet.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	err := s.voter.Execute(m)
+	s.Nil(err)
+
+	submitted := make(chan []*message.Message, 1)
+	mockSubmitter.EXPECT().Submit(gomock.Any(), []*message.Message{m}).DoAndReturn(func(ctx context.Context, msgs []*message.Message) error {
+		submitted <- msgs
+		return nil
+	})
+	s.voter.HandleTimeout(common.Hash{2})
+	s.voter.HandleTimeout(common.Hash{1})
+	// message is resubmitted only once
+	s.voter.HandleTimeout(common.Hash{1})
+
+	select {
+	case <-submitted:
+	case <-time.After(time.Second):
+		s.Fail("message not resubmitted")
+	}
+}
+
 func (s *VoterTestSuite) TestExecute_IsProposalVotedByError() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
 	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
 	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, errors.New("error"))
 
 	err := s.voter.Execute(&message.Message{})
@@ -104,6 +185,7 @@ func (s *VoterTestSuite) TestExecute_ProposalAlreadyVoted() {
 		DepositNonce: 0,
 	}, nil)
 	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
 	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(true, nil)
 
 	err := s.voter.Execute(&message.Message{})
@@ -111,12 +193,29 @@ func (s *VoterTestSuite) TestExecute_ProposalAlreadyVoted() {
 	s.Nil(err)
 }
 
+func (s *VoterTestSuite) TestExecute_ProposalAlreadyVotedWithAdditionalKey() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(common.Address{}, gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(common.HexToAddress("0x2"), gomock.Any()).Return(true, nil)
+	s.voter.RegisterRelayerAddress(common.HexToAddress("0x2"))
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.Nil(err)
+}
+
 func (s *VoterTestSuite) TestExecute_ProposalStatusFail() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
 	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
 	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
 	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{}, errors.New("error"))
 
@@ -131,8 +230,28 @@ func (s *VoterTestSuite) TestExecute_ExecutedProposal() {
 		DepositNonce: 0,
 	}, nil)
 	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusExecuted}, nil)
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestExecute_ExecutedProposalTracksStatus() {
+	mockStatusTracker := mock_voter.NewMockProposalStatusTracker(gomock.NewController(s.T()))
+	s.voter.RegisterStatusTracker(mockStatusTracker)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       1,
+		Destination:  2,
+		DepositNonce: 3,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
 	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
 	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusExecuted}, nil)
+	mockStatusTracker.EXPECT().TrackProposalStatus(uint8(1), uint8(2), uint64(3), message.ProposalStatusExecuted)
 
 	err := s.voter.Execute(&message.Message{})
 
@@ -145,11 +264,55 @@ func (s *VoterTestSuite) TestExecute_GetThresholdFail() {
 		DepositNonce: 0,
 	}, nil)
 	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(true, nil)
 	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
 	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
-	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(0), errors.New("error"))
+	s.mockRelayerSet.EXPECT().GetThreshold().Return(uint8(0), errors.New("error"))
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.NotNil(err)
+}
+
+func (s *VoterTestSuite) TestExecute_IsRelayerError() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(false, errors.New("error"))
 
 	err := s.voter.Execute(&message.Message{})
 
 	s.NotNil(err)
 }
+
+func (s *VoterTestSuite) TestExecute_RemovedRelayerDoesNotVote() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(false, nil)
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.NotNil(err)
+}
+
+func (s *VoterTestSuite) TestExecute_RemovedPrimaryKeyVotesWithAdditionalKey() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockRelayerSet.EXPECT().IsRelayer(common.Address{}).Return(false, nil)
+	s.mockRelayerSet.EXPECT().IsRelayer(common.HexToAddress("0x2")).Return(true, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(common.Address{}, gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(common.HexToAddress("0x2"), gomock.Any()).Return(true, nil)
+	s.voter.RegisterRelayerAddress(common.HexToAddress("0x2"))
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.Nil(err)
+}
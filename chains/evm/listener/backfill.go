@@ -0,0 +1,120 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// backfillRange is a block range fetched by a backfill worker.
+// End block is not included in the range.
+type backfillRange struct {
+	startBlock *big.Int
+	endBlock   *big.Int
+	hash       common.Hash
+	handles    []HandleFunc
+	err        error
+}
+
+func (r *backfillRange) lastBlock() *big.Int {
+	return new(big.Int).Sub(r.endBlock, big.NewInt(1))
+}
+
+// backfillRanges splits confirmed blocks after startBlock into up to
+// backfillWorkers ranges of block interval size.
+func (l *EVMListener) backfillRanges(startBlock *big.Int, head *big.Int) []*backfillRange {
+	ranges := make([]*backfillRange, 0)
+	if l.backfillWorkers <= 1 {
+		return ranges
+	}
+
+	confirmedEnd := new(big.Int).Sub(head, l.blockConfirmations)
+	start := new(big.Int).Set(startBlock)
+	for len(ranges) < l.backfillWorkers {
+		end := new(big.Int).Add(start, l.blockInterval)
+		if end.Cmp(confirmedEnd) == 1 {
+			break
+		}
+
+		ranges = append(ranges, &backfillRange{startBlock: start, endBlock: end})
+		start = end
+	}
+	return ranges
+}
+
+// backfill fetches events from block ranges concurrently and handles them
+// serially in block order, so side effects of event handlers and messages sent
+// to the relayer follow the chain. The blockstore is moved only up to the end
+// of the last range that, together with all ranges before it, was handled
+// successfully, which is returned as the next start block. Error is returned
+// if the first range couldn't be handled.
+func (l *EVMListener) backfill(ctx context.Context, ranges []*backfillRange, head *big.Int, msgChan chan []*message.Message) (*big.Int, error) {
+	l.log.Debug().Msgf("Backfilling block range %s-%s with %d workers", ranges[0].startBlock, ranges[len(ranges)-1].endBlock, len(ranges))
+
+	wg := sync.WaitGroup{}
+	for _, r := range ranges {
+		wg.Add(1)
+		go func(r *backfillRange) {
+			defer wg.Done()
+			l.fetchRange(ctx, r)
+		}(r)
+	}
+	wg.Wait()
+
+	startBlock := ranges[0].startBlock
+	var err error
+loop:
+	for _, r := range ranges {
+		if r.err != nil {
+			err = r.err
+			l.log.Warn().Err(r.err).Msgf("Unable to fetch events in block range %s-%s", r.startBlock, r.endBlock)
+			if isRangeLimitError(r.err) {
+				l.shrinkBlockInterval()
+			}
+			break
+		}
+
+		for _, handle := range r.handles {
+			err = handle(ctx, msgChan)
+			if err != nil {
+				l.log.Warn().Err(err).Msgf("Unable to handle events in block range %s-%s", r.startBlock, r.endBlock)
+				break loop
+			}
+		}
+
+		l.trackBlockHash(r.lastBlock(), r.hash)
+		startBlock = r.endBlock
+	}
+	if startBlock == ranges[0].startBlock {
+		return startBlock, err
+	}
+
+	l.metrics.TrackBlockDelta(l.domainID, head, startBlock)
+	err = l.blockstore.StoreBlockHashes(l.domainID, l.blockHashes)
+	if err != nil {
+		l.log.Error().Str("block", startBlock.String()).Err(err).Msg("Failed to write block hashes to blockstore")
+	}
+	err = l.blockstore.StoreBlock(startBlock, l.domainID)
+	if err != nil {
+		l.log.Error().Str("block", startBlock.String()).Err(err).Msg("Failed to write latest block to blockstore")
+	}
+
+	l.growBlockInterval(head, startBlock)
+	return startBlock, nil
+}
+
+// fetchRange fetches the hash of the last block and events of all event
+// handlers in the block range without handling them.
+func (l *EVMListener) fetchRange(ctx context.Context, r *backfillRange) {
+	r.hash, r.err = l.client.BlockHash(r.lastBlock())
+	if r.err != nil {
+		return
+	}
+	r.handles, r.err = l.fetchEvents(ctx, r.startBlock, r.lastBlock())
+}
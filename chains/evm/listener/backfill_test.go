@@ -0,0 +1,197 @@
+package listener_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	mock_listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BackfillListenerTestSuite struct {
+	suite.Suite
+	listener            *listener.EVMListener
+	mockClient          *mock_listener.MockChainClient
+	mockEventHandler    *mock_listener.MockEventHandler
+	mockBlockStorer     *mock_listener.MockBlockStorer
+	mockRetractor       *mock_listener.MockMessageRetractor
+	mockBlockDeltaMeter *mock_listener.MockBlockDeltaMeter
+	domainID            uint8
+}
+
+func TestRunBackfillListenerTestSuite(t *testing.T) {
+	suite.Run(t, new(BackfillListenerTestSuite))
+}
+
+func (s *BackfillListenerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.domainID = 1
+	s.mockClient = mock_listener.NewMockChainClient(ctrl)
+	s.mockEventHandler = mock_listener.NewMockEventHandler(ctrl)
+	s.mockBlockStorer = mock_listener.NewMockBlockStorer(ctrl)
+	s.mockRetractor = mock_listener.NewMockMessageRetractor(ctrl)
+	s.mockBlockDeltaMeter = mock_listener.NewMockBlockDeltaMeter(ctrl)
+	s.listener = listener.NewEVMListener(
+		s.mockClient,
+		[]listener.EventHandler{s.mockEventHandler},
+		s.mockBlockStorer,
+		s.mockRetractor,
+		s.mockBlockDeltaMeter,
+		s.domainID,
+		time.Millisecond*75,
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(10),
+		3)
+}
+
+func (s *BackfillListenerTestSuite) Test_ListenToEvents_SendsMessagesInBlockOrder() {
+	head := big.NewInt(200)
+	msgChan := make(chan []*message.Message, 3)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(109)).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(114)).Return(common.Hash{3}, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(100), big.NewInt(104)).DoAndReturn(func(ctx context.Context, startBlock, endBlock *big.Int) (listener.HandleFunc, error) {
+		time.Sleep(time.Millisecond * 10)
+		return handleMessages([]*message.Message{{DepositNonce: 1}}), nil
+	})
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(105), big.NewInt(109)).Return(handleNothing, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(110), big.NewInt(114)).Return(handleMessages([]*message.Message{{DepositNonce: 2}}), nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(s.domainID, head, big.NewInt(115))
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{
+		{Number: big.NewInt(104), Hash: common.Hash{1}},
+		{Number: big.NewInt(109), Hash: common.Hash{2}},
+		{Number: big.NewInt(114), Hash: common.Hash{3}},
+	}).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(115), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+	s.Equal([]*message.Message{{DepositNonce: 1}}, <-msgChan)
+	s.Equal([]*message.Message{{DepositNonce: 2}}, <-msgChan)
+}
+
+func (s *BackfillListenerTestSuite) Test_ListenToEvents_StoresOnlyContiguousProcessedBlocks() {
+	head := big.NewInt(200)
+	msgChan := make(chan []*message.Message, 3)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(109)).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(114)).Return(common.Hash{3}, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(100), big.NewInt(104)).Return(handleNothing, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(105), big.NewInt(109)).Return(nil, fmt.Errorf("error"))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(110), big.NewInt(114)).Return(handleMessages([]*message.Message{{DepositNonce: 2}}), nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(s.domainID, head, big.NewInt(105))
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{{Number: big.NewInt(104), Hash: common.Hash{1}}}).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(105), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+	s.Equal(0, len(msgChan))
+}
+
+func (s *BackfillListenerTestSuite) Test_ListenToEvents_HandlesEventsInBlockOrder() {
+	head := big.NewInt(200)
+	msgChan := make(chan []*message.Message, 3)
+	handled := make(chan int, 3)
+	handleRange := func(i int) listener.HandleFunc {
+		return func(ctx context.Context, msgChan chan []*message.Message) error {
+			handled <- i
+			return nil
+		}
+	}
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(109)).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(114)).Return(common.Hash{3}, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(100), big.NewInt(104)).DoAndReturn(func(ctx context.Context, startBlock, endBlock *big.Int) (listener.HandleFunc, error) {
+		time.Sleep(time.Millisecond * 10)
+		return handleRange(1), nil
+	})
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(105), big.NewInt(109)).DoAndReturn(func(ctx context.Context, startBlock, endBlock *big.Int) (listener.HandleFunc, error) {
+		time.Sleep(time.Millisecond * 5)
+		return handleRange(2), nil
+	})
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(110), big.NewInt(114)).Return(handleRange(3), nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(s.domainID, head, big.NewInt(115))
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(115), s.domainID).Return(nil)
+	// prevent infinite runs
+	polled := make(chan struct{})
+	s.mockClient.EXPECT().LatestBlock().DoAndReturn(func() (*big.Int, error) {
+		close(polled)
+		return big.NewInt(95), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	select {
+	case <-polled:
+	case <-time.After(time.Second):
+		s.Fail("listener did not finish handling the range")
+	}
+	cancel()
+	order := make([]int, 0)
+	for len(handled) > 0 {
+		order = append(order, <-handled)
+	}
+	s.Equal([]int{1, 2, 3}, order)
+}
+
+func (s *BackfillListenerTestSuite) Test_ListenToEvents_StopsSendingMessagesWhenContextDone() {
+	head := big.NewInt(200)
+	msgChan := make(chan []*message.Message)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(gomock.Any()).Return(common.Hash{1}, nil).Times(3)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), gomock.Any(), gomock.Any()).Return(handleMessages([]*message.Message{{DepositNonce: 1}}), nil).Times(3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+
+	go func() {
+		s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+		close(stopped)
+	}()
+
+	time.Sleep(time.Millisecond * 20)
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		s.Fail("listener blocked on message channel")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrDepositNotFound = errors.New("deposit not found")
+
+type DepositCounter interface {
+	DepositCount(destinationDomainID uint8) (uint64, error)
+}
+
+// DepositFinder rebuilds messages from deposit events on the bridge, so
+// messages can be relayed only for deposits that were made on chain.
+type DepositFinder struct {
+	client         ChainClient
+	eventListener  EventListener
+	depositHandler DepositHandler
+	depositCounter DepositCounter
+
+	bridgeAddress      common.Address
+	domainID           uint8
+	blockConfirmations *big.Int
+	blockInterval      *big.Int
+}
+
+// NewDepositFinder creates a DepositFinder that looks for deposits in confirmed
+// blocks, going back from head blockInterval blocks at a time
+func NewDepositFinder(
+	client ChainClient,
+	eventListener EventListener,
+	depositHandler DepositHandler,
+	depositCounter DepositCounter,
+	bridgeAddress common.Address,
+	domainID uint8,
+	blockConfirmations *big.Int,
+	blockInterval *big.Int,
+) *DepositFinder {
+	return &DepositFinder{
+		client:             client,
+		eventListener:      eventListener,
+		depositHandler:     depositHandler,
+		depositCounter:     depositCounter,
+		bridgeAddress:      bridgeAddress,
+		domainID:           domainID,
+		blockConfirmations: blockConfirmations,
+		blockInterval:      blockInterval,
+	}
+}
+
+// FindDeposit returns message of the deposit with depositNonce made to destination.
+// Deposit nonces grow with blocks, so search stops at the first older deposit
+// to destination. ErrDepositNotFound is returned if there is no such confirmed deposit.
+func (f *DepositFinder) FindDeposit(ctx context.Context, destination uint8, depositNonce uint64) (*message.Message, error) {
+	count, err := f.depositCounter.DepositCount(destination)
+	if err != nil {
+		return nil, err
+	}
+	if depositNonce == 0 || depositNonce > count {
+		return nil, ErrDepositNotFound
+	}
+
+	head, err := f.client.LatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	endBlock := new(big.Int).Sub(head, f.blockConfirmations)
+	for endBlock.Sign() >= 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		startBlock := new(big.Int).Sub(endBlock, f.blockInterval)
+		startBlock.Add(startBlock, big.NewInt(1))
+		if startBlock.Sign() == -1 {
+			startBlock = big.NewInt(0)
+		}
+		deposits, err := f.eventListener.FetchDeposits(ctx, f.bridgeAddress, startBlock, endBlock)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch deposit events because of: %w", err)
+		}
+
+		older := false
+		for _, d := range deposits {
+			if d.DestinationDomainID != destination {
+				continue
+			}
+			if d.DepositNonce == depositNonce {
+				return depositMessage(f.depositHandler, f.domainID, d)
+			}
+			if d.DepositNonce < depositNonce {
+				older = true
+			}
+		}
+		if older {
+			break
+		}
+		endBlock = startBlock.Sub(startBlock, big.NewInt(1))
+	}
+	return nil, ErrDepositNotFound
+}
@@ -0,0 +1,96 @@
+package listener_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	mock_listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DepositFinderTestSuite struct {
+	suite.Suite
+	depositFinder      *listener.DepositFinder
+	mockClient         *mock_listener.MockChainClient
+	mockEventListener  *mock_listener.MockEventListener
+	mockDepositHandler *mock_listener.MockDepositHandler
+	mockDepositCounter *mock_listener.MockDepositCounter
+}
+
+func TestRunDepositFinderTestSuite(t *testing.T) {
+	suite.Run(t, new(DepositFinderTestSuite))
+}
+
+func (s *DepositFinderTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockClient = mock_listener.NewMockChainClient(ctrl)
+	s.mockEventListener = mock_listener.NewMockEventListener(ctrl)
+	s.mockDepositHandler = mock_listener.NewMockDepositHandler(ctrl)
+	s.mockDepositCounter = mock_listener.NewMockDepositCounter(ctrl)
+	s.depositFinder = listener.NewDepositFinder(s.mockClient, s.mockEventListener, s.mockDepositHandler, s.mockDepositCounter, common.Address{}, 1, big.NewInt(5), big.NewInt(10))
+}
+
+func (s *DepositFinderTestSuite) Test_FindDeposit_NonceNotUsed() {
+	s.mockDepositCounter.EXPECT().DepositCount(uint8(2)).Return(uint64(3), nil)
+
+	_, err := s.depositFinder.FindDeposit(context.Background(), 2, 4)
+
+	s.ErrorIs(err, listener.ErrDepositNotFound)
+}
+
+func (s *DepositFinderTestSuite) Test_FindDeposit_SearchesBackFromConfirmedHead() {
+	d := &events.Deposit{DestinationDomainID: 2, DepositNonce: 3, TxHash: common.Hash{1}, BlockNumber: 80}
+	s.mockDepositCounter.EXPECT().DepositCount(uint8(2)).Return(uint64(4), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(105), nil)
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), common.Address{}, big.NewInt(91), big.NewInt(100)).Return([]*events.Deposit{
+		{DestinationDomainID: 3, DepositNonce: 1},
+	}, nil)
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), common.Address{}, big.NewInt(81), big.NewInt(90)).Return([]*events.Deposit{}, nil)
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), common.Address{}, big.NewInt(71), big.NewInt(80)).Return([]*events.Deposit{d}, nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(uint8(1), uint8(2), uint64(3), d.ResourceID, d.Data, d.HandlerResponse).Return(&message.Message{Source: 1, Destination: 2, DepositNonce: 3}, nil)
+
+	m, err := s.depositFinder.FindDeposit(context.Background(), 2, 3)
+
+	s.Nil(err)
+	s.Equal(&message.Message{Source: 1, Destination: 2, DepositNonce: 3, Origin: message.Origin{TxHash: common.Hash{1}, BlockNumber: 80}}, m)
+}
+
+func (s *DepositFinderTestSuite) Test_FindDeposit_StopsAtOlderDeposit() {
+	s.mockDepositCounter.EXPECT().DepositCount(uint8(2)).Return(uint64(4), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(105), nil)
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), common.Address{}, big.NewInt(91), big.NewInt(100)).Return([]*events.Deposit{
+		{DestinationDomainID: 2, DepositNonce: 2},
+	}, nil)
+
+	_, err := s.depositFinder.FindDeposit(context.Background(), 2, 3)
+
+	s.ErrorIs(err, listener.ErrDepositNotFound)
+}
+
+func (s *DepositFinderTestSuite) Test_FindDeposit_StopsAtGenesis() {
+	s.mockDepositCounter.EXPECT().DepositCount(uint8(2)).Return(uint64(4), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(12), nil)
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), common.Address{}, big.NewInt(0), big.NewInt(7)).Return([]*events.Deposit{}, nil)
+
+	_, err := s.depositFinder.FindDeposit(context.Background(), 2, 3)
+
+	s.ErrorIs(err, listener.ErrDepositNotFound)
+}
+
+func (s *DepositFinderTestSuite) Test_FindDeposit_FetchFails() {
+	s.mockDepositCounter.EXPECT().DepositCount(uint8(2)).Return(uint64(4), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(105), nil)
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+	_, err := s.depositFinder.FindDeposit(context.Background(), 2, 3)
+
+	s.NotNil(err)
+	s.NotErrorIs(err, listener.ErrDepositNotFound)
+}
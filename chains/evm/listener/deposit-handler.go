@@ -89,10 +89,10 @@ func Erc20DepositHandler(sourceID, destId uint8, nonce uint64, resourceID types.
 	recipientAddress := calldata[64:(64 + recipientAddressLength.Int64())]
 
 	// if there is priority data, parse it and use it
-	payload := []interface{}{
-		amount,
-		recipientAddress,
-	}
+	payload := message.FungiblePayload{
+		Amount:    new(big.Int).SetBytes(amount),
+		Recipient: recipientAddress,
+	}.Payload()
 
 	// arbitrary metadata that will be most likely be used by the relayer
 	var metadata message.Metadata
@@ -116,9 +116,9 @@ func GenericDepositHandler(sourceID, destId uint8, nonce uint64, resourceID type
 	// first 32 bytes are metadata length
 	metadataLen := big.NewInt(0).SetBytes(calldata[:32])
 	metadata := calldata[32 : 32+metadataLen.Int64()]
-	payload := []interface{}{
-		metadata,
-	}
+	payload := message.GenericPayload{
+		Metadata: metadata,
+	}.Payload()
 
 	// generic handler has specific payload length and doesn't support arbitrary metadata
 	meta := message.Metadata{}
@@ -155,11 +155,11 @@ func Erc721DepositHandler(sourceID, destId uint8, nonce uint64, resourceID types
 	// arbitrary metadata that will be most likely be used by the relayer
 	var meta message.Metadata
 
-	payload := []interface{}{
-		tokenId,
-		recipientAddress,
-		metadata,
-	}
+	payload := message.NonFungiblePayload{
+		TokenID:   new(big.Int).SetBytes(tokenId),
+		Recipient: recipientAddress,
+		Metadata:  metadata,
+	}.Payload()
 
 	if 64+recipientAddressLength.Int64()+32+metadataLength.Int64() < int64(len(calldata)) {
 		// (metadataStart + metadataLength) - (metadataStart + metadataLength + 1) is priority length
@@ -20,27 +20,49 @@ type DepositHandler interface {
 	HandleDeposit(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte) (*message.Message, error)
 }
 
+type MessageStorer interface {
+	StoreMessages(block *big.Int, msgs []*message.Message) error
+}
+
+type DepositTracker interface {
+	TrackDeposit(m *message.Message)
+}
+
 type DepositEventHandler struct {
 	eventListener  EventListener
 	depositHandler DepositHandler
+	messageStorer  MessageStorer
+	trackers       []DepositTracker
 
 	bridgeAddress common.Address
 	domainID      uint8
 }
 
-func NewDepositEventHandler(eventListener EventListener, depositHandler DepositHandler, bridgeAddress common.Address, domainID uint8) *DepositEventHandler {
+// NewDepositEventHandler creates an instance of DepositEventHandler that converts
+// deposit events into messages and persists them with messageStorer before
+// sending them to the relayer
+func NewDepositEventHandler(eventListener EventListener, depositHandler DepositHandler, messageStorer MessageStorer, bridgeAddress common.Address, domainID uint8) *DepositEventHandler {
 	return &DepositEventHandler{
 		eventListener:  eventListener,
 		depositHandler: depositHandler,
+		messageStorer:  messageStorer,
+		trackers:       make([]DepositTracker, 0),
 		bridgeAddress:  bridgeAddress,
 		domainID:       domainID,
 	}
 }
 
-func (eh *DepositEventHandler) HandleEvent(startBlock *big.Int, endBlock *big.Int, msgChan chan []*message.Message) error {
-	deposits, err := eh.eventListener.FetchDeposits(context.Background(), eh.bridgeAddress, startBlock, endBlock)
+// RegisterTracker registers tracker notified of every stored deposit
+func (eh *DepositEventHandler) RegisterTracker(tracker DepositTracker) {
+	eh.trackers = append(eh.trackers, tracker)
+}
+
+// FetchEvents fetches deposits from the block range and converts them into messages.
+// Returned function persists the messages and sends them to the relayer.
+func (eh *DepositEventHandler) FetchEvents(ctx context.Context, startBlock *big.Int, endBlock *big.Int) (HandleFunc, error) {
+	deposits, err := eh.eventListener.FetchDeposits(ctx, eh.bridgeAddress, startBlock, endBlock)
 	if err != nil {
-		return fmt.Errorf("unable to fetch deposit events because of: %+v", err)
+		return nil, fmt.Errorf("unable to fetch deposit events because of: %w", err)
 	}
 
 	domainDeposits := make(map[uint8][]*message.Message)
@@ -52,7 +74,7 @@ func (eh *DepositEventHandler) HandleEvent(startBlock *big.Int, endBlock *big.In
 				}
 			}()
 
-			m, err := eh.depositHandler.HandleDeposit(eh.domainID, d.DestinationDomainID, d.DepositNonce, d.ResourceID, d.Data, d.HandlerResponse)
+			m, err := depositMessage(eh.depositHandler, eh.domainID, d)
 			if err != nil {
 				log.Error().Err(err).Str("start block", startBlock.String()).Str("end block", endBlock.String()).Uint8("domainID", eh.domainID).Msgf("%v", err)
 				return
@@ -63,11 +85,49 @@ func (eh *DepositEventHandler) HandleEvent(startBlock *big.Int, endBlock *big.In
 		}(d)
 	}
 
+	return func(ctx context.Context, msgChan chan []*message.Message) error {
+		return eh.handleMessages(ctx, endBlock, domainDeposits, msgChan)
+	}, nil
+}
+
+func (eh *DepositEventHandler) handleMessages(ctx context.Context, endBlock *big.Int, domainDeposits map[uint8][]*message.Message, msgChan chan []*message.Message) error {
+	// messages are persisted before the handler returns so the listener
+	// doesn't store the block until they can be redelivered
 	for _, deposits := range domainDeposits {
-		go func(d []*message.Message) {
-			msgChan <- d
-		}(deposits)
+		err := eh.messageStorer.StoreMessages(endBlock, deposits)
+		if err != nil {
+			return fmt.Errorf("unable to store messages because of: %w", err)
+		}
+	}
+
+	for _, deposits := range domainDeposits {
+		for _, m := range deposits {
+			for _, tracker := range eh.trackers {
+				tracker.TrackDeposit(m)
+			}
+		}
+		select {
+		case msgChan <- deposits:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 
 	return nil
 }
+
+// depositMessage converts deposit event into a message. Message that can't be
+// encoded is rejected, as it couldn't be persisted in the outbox.
+func depositMessage(depositHandler DepositHandler, domainID uint8, d *events.Deposit) (*message.Message, error) {
+	m, err := depositHandler.HandleDeposit(domainID, d.DestinationDomainID, d.DepositNonce, d.ResourceID, d.Data, d.HandlerResponse)
+	if err != nil {
+		return nil, err
+	}
+	m.Origin = message.Origin{TxHash: d.TxHash, BlockNumber: d.BlockNumber, Sender: d.SenderAddress}
+
+	_, err = m.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode message of deposit %d to %d: %w", d.DepositNonce, d.DestinationDomainID, err)
+	}
+	return m, nil
+}
@@ -1,6 +1,7 @@
 package listener_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -20,6 +21,7 @@ type DepositHandlerTestSuite struct {
 	depositEventHandler *listener.DepositEventHandler
 	mockDepositHandler  *mock_listener.MockDepositHandler
 	mockEventListener   *mock_listener.MockEventListener
+	mockMessageStorer   *mock_listener.MockMessageStorer
 	domainID            uint8
 }
 
@@ -32,17 +34,16 @@ func (s *DepositHandlerTestSuite) SetupTest() {
 	s.domainID = 1
 	s.mockEventListener = mock_listener.NewMockEventListener(ctrl)
 	s.mockDepositHandler = mock_listener.NewMockDepositHandler(ctrl)
-	s.depositEventHandler = listener.NewDepositEventHandler(s.mockEventListener, s.mockDepositHandler, common.Address{}, s.domainID)
+	s.mockMessageStorer = mock_listener.NewMockMessageStorer(ctrl)
+	s.depositEventHandler = listener.NewDepositEventHandler(s.mockEventListener, s.mockDepositHandler, s.mockMessageStorer, common.Address{}, s.domainID)
 }
 
 func (s *DepositHandlerTestSuite) Test_FetchDepositFails() {
 	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*events.Deposit{}, fmt.Errorf("error"))
 
-	msgChan := make(chan []*message.Message, 1)
-	err := s.depositEventHandler.HandleEvent(big.NewInt(0), big.NewInt(5), msgChan)
+	_, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
 
 	s.NotNil(err)
-	s.Equal(len(msgChan), 0)
 }
 
 func (s *DepositHandlerTestSuite) Test_HandleDepositFails_ExecutionContinue() {
@@ -52,6 +53,9 @@ func (s *DepositHandlerTestSuite) Test_HandleDepositFails_ExecutionContinue() {
 		ResourceID:          types.ResourceID{},
 		HandlerResponse:     []byte{},
 		Data:                []byte{},
+		SenderAddress:       common.HexToAddress("0x1"),
+		TxHash:              common.HexToHash("0x2"),
+		BlockNumber:         3,
 	}
 	d2 := &events.Deposit{
 		DepositNonce:        2,
@@ -81,15 +85,47 @@ func (s *DepositHandlerTestSuite) Test_HandleDepositFails_ExecutionContinue() {
 		&message.Message{DepositNonce: 2},
 		nil,
 	)
+	s.mockMessageStorer.EXPECT().StoreMessages(big.NewInt(5), gomock.Any()).Return(nil)
 
 	msgChan := make(chan []*message.Message, 2)
-	err := s.depositEventHandler.HandleEvent(big.NewInt(0), big.NewInt(5), msgChan)
+	handle, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
 	msgs := <-msgChan
 
 	s.Nil(err)
 	s.Equal(msgs, []*message.Message{{DepositNonce: 2}})
 }
 
+func (s *DepositHandlerTestSuite) Test_SkipsMessageThatCannotBeEncoded() {
+	d1 := &events.Deposit{
+		DepositNonce:        1,
+		DestinationDomainID: 2,
+	}
+	d2 := &events.Deposit{
+		DepositNonce:        2,
+		DestinationDomainID: 2,
+	}
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*events.Deposit{d1, d2}, nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(s.domainID, uint8(2), uint64(1), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&message.Message{DepositNonce: 1, Metadata: message.Metadata{Data: map[string]interface{}{"fee": 1.5}}},
+		nil,
+	)
+	s.mockDepositHandler.EXPECT().HandleDeposit(s.domainID, uint8(2), uint64(2), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&message.Message{DepositNonce: 2},
+		nil,
+	)
+	s.mockMessageStorer.EXPECT().StoreMessages(big.NewInt(5), []*message.Message{{DepositNonce: 2}}).Return(nil)
+
+	msgChan := make(chan []*message.Message, 2)
+	handle, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
+
+	s.Nil(err)
+	s.Equal([]*message.Message{{DepositNonce: 2}}, <-msgChan)
+}
+
 func (s *DepositHandlerTestSuite) Test_HandleDepositPanis_ExecutionContinues() {
 	d1 := &events.Deposit{
 		DepositNonce:        1,
@@ -97,6 +133,9 @@ func (s *DepositHandlerTestSuite) Test_HandleDepositPanis_ExecutionContinues() {
 		ResourceID:          types.ResourceID{},
 		HandlerResponse:     []byte{},
 		Data:                []byte{},
+		SenderAddress:       common.HexToAddress("0x1"),
+		TxHash:              common.HexToHash("0x2"),
+		BlockNumber:         3,
 	}
 	d2 := &events.Deposit{
 		DepositNonce:        2,
@@ -128,9 +167,12 @@ func (s *DepositHandlerTestSuite) Test_HandleDepositPanis_ExecutionContinues() {
 		&message.Message{DepositNonce: 2},
 		nil,
 	)
+	s.mockMessageStorer.EXPECT().StoreMessages(big.NewInt(5), gomock.Any()).Return(nil)
 
 	msgChan := make(chan []*message.Message, 2)
-	err := s.depositEventHandler.HandleEvent(big.NewInt(0), big.NewInt(5), msgChan)
+	handle, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
 	msgs := <-msgChan
 
 	s.Nil(err)
@@ -144,6 +186,9 @@ func (s *DepositHandlerTestSuite) Test_SuccessfulHandleDeposit() {
 		ResourceID:          types.ResourceID{},
 		HandlerResponse:     []byte{},
 		Data:                []byte{},
+		SenderAddress:       common.HexToAddress("0x1"),
+		TxHash:              common.HexToHash("0x2"),
+		BlockNumber:         3,
 	}
 	d2 := &events.Deposit{
 		DepositNonce:        2,
@@ -176,11 +221,82 @@ func (s *DepositHandlerTestSuite) Test_SuccessfulHandleDeposit() {
 		&message.Message{DepositNonce: 2},
 		nil,
 	)
+	s.mockMessageStorer.EXPECT().StoreMessages(big.NewInt(5), gomock.Any()).Return(nil)
 
 	msgChan := make(chan []*message.Message, 2)
-	err := s.depositEventHandler.HandleEvent(big.NewInt(0), big.NewInt(5), msgChan)
+	handle, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
 	msgs := <-msgChan
 
 	s.Nil(err)
-	s.Equal(msgs, []*message.Message{{DepositNonce: 1}, {DepositNonce: 2}})
+	s.Equal(msgs, []*message.Message{
+		{DepositNonce: 1, Origin: message.Origin{TxHash: common.HexToHash("0x2"), BlockNumber: 3, Sender: common.HexToAddress("0x1")}},
+		{DepositNonce: 2},
+	})
+}
+
+func (s *DepositHandlerTestSuite) Test_StopsSendingMessagesWhenContextDone() {
+	d := &events.Deposit{DepositNonce: 1, DestinationDomainID: 2}
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*events.Deposit{d}, nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&message.Message{Destination: 2, DepositNonce: 1}, nil)
+	s.mockMessageStorer.EXPECT().StoreMessages(big.NewInt(5), gomock.Any()).Return(nil)
+
+	handle, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = handle(ctx, make(chan []*message.Message))
+
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *DepositHandlerTestSuite) Test_TracksStoredDeposits() {
+	mockTracker := mock_listener.NewMockDepositTracker(gomock.NewController(s.T()))
+	s.depositEventHandler.RegisterTracker(mockTracker)
+	d := &events.Deposit{DepositNonce: 1, DestinationDomainID: 2}
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*events.Deposit{d}, nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&message.Message{Destination: 2, DepositNonce: 1}, nil)
+	s.mockMessageStorer.EXPECT().StoreMessages(big.NewInt(5), gomock.Any()).Return(nil)
+	mockTracker.EXPECT().TrackDeposit(&message.Message{Destination: 2, DepositNonce: 1})
+
+	msgChan := make(chan []*message.Message, 1)
+	handle, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
+
+	s.Nil(err)
+	s.Len(msgChan, 1)
+}
+
+func (s *DepositHandlerTestSuite) Test_StoreMessagesFails() {
+	d1 := &events.Deposit{
+		DepositNonce:        1,
+		DestinationDomainID: 2,
+		ResourceID:          types.ResourceID{},
+		HandlerResponse:     []byte{},
+		Data:                []byte{},
+	}
+	deposits := []*events.Deposit{d1}
+	s.mockEventListener.EXPECT().FetchDeposits(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(deposits, nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(
+		s.domainID,
+		d1.DestinationDomainID,
+		d1.DepositNonce,
+		d1.ResourceID,
+		d1.Data,
+		d1.HandlerResponse,
+	).Return(
+		&message.Message{DepositNonce: 1},
+		nil,
+	)
+	s.mockMessageStorer.EXPECT().StoreMessages(big.NewInt(5), []*message.Message{{DepositNonce: 1}}).Return(fmt.Errorf("error"))
+
+	msgChan := make(chan []*message.Message, 1)
+	handle, err := s.depositEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
+
+	s.NotNil(err)
+	s.Equal(len(msgChan), 0)
 }
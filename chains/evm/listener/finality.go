@@ -0,0 +1,80 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	FinalizedTag = "finalized"
+	SafeTag      = "safe"
+)
+
+// JSON-RPC error codes returned by nodes that don't support finality tags
+const (
+	methodNotFoundCode = -32601
+	invalidParamsCode  = -32602
+)
+
+// FinalityClient reports the block with provided finality tag as the latest block
+// so listener processes only blocks the chain considers final.
+// If the chain doesn't support the tag, latest block minus block confirmations is used instead.
+type FinalityClient struct {
+	TaggedBlockClient
+	tag                string
+	blockConfirmations *big.Int
+	unsupported        atomic.Bool
+}
+
+func NewFinalityClient(client TaggedBlockClient, tag string, blockConfirmations *big.Int) *FinalityClient {
+	return &FinalityClient{
+		TaggedBlockClient:  client,
+		tag:                tag,
+		blockConfirmations: blockConfirmations,
+	}
+}
+
+// LatestBlock returns the latest block with finality tag or the latest
+// confirmed block if the tag is not supported. Other errors are returned
+// so blocks that may not be final aren't processed.
+func (c *FinalityClient) LatestBlock() (*big.Int, error) {
+	if !c.unsupported.Load() {
+		block, err := c.TaggedBlock(c.tag)
+		if err == nil {
+			return block, nil
+		}
+
+		if !isTagUnsupported(err) {
+			return nil, err
+		}
+		log.Warn().Err(err).Msgf("Block tag %s not supported, falling back to %s block confirmations", c.tag, c.blockConfirmations)
+		c.unsupported.Store(true)
+	}
+
+	head, err := c.TaggedBlockClient.LatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	confirmed := new(big.Int).Sub(head, c.blockConfirmations)
+	if confirmed.Sign() == -1 {
+		return big.NewInt(0), nil
+	}
+	return confirmed, nil
+}
+
+// isTagUnsupported returns true if err shows that the node doesn't know
+// the block tag rather than that the request failed
+func isTagUnsupported(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return rpcErr.ErrorCode() == methodNotFoundCode || rpcErr.ErrorCode() == invalidParamsCode
+}
@@ -0,0 +1,96 @@
+package listener_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	mock_listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener/mock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FinalityClientTestSuite struct {
+	suite.Suite
+	finalityClient *listener.FinalityClient
+	mockClient     *mock_listener.MockTaggedBlockClient
+}
+
+func TestRunFinalityClientTestSuite(t *testing.T) {
+	suite.Run(t, new(FinalityClientTestSuite))
+}
+
+func (s *FinalityClientTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockClient = mock_listener.NewMockTaggedBlockClient(ctrl)
+	s.finalityClient = listener.NewFinalityClient(s.mockClient, listener.FinalizedTag, big.NewInt(10))
+}
+
+func (s *FinalityClientTestSuite) Test_LatestBlock_ReturnsTaggedBlock() {
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(big.NewInt(100), nil)
+
+	block, err := s.finalityClient.LatestBlock()
+
+	s.Nil(err)
+	s.Equal(big.NewInt(100), block)
+}
+
+type rpcError struct {
+	code int
+}
+
+func (e rpcError) Error() string  { return "invalid argument 0: hex string without 0x prefix" }
+func (e rpcError) ErrorCode() int { return e.code }
+
+func (s *FinalityClientTestSuite) Test_LatestBlock_FallsBackToConfirmationsIfTagUnsupported() {
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(nil, rpcError{code: -32602})
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(110), nil).Times(2)
+
+	block, err := s.finalityClient.LatestBlock()
+	s.Nil(err)
+	s.Equal(big.NewInt(100), block)
+
+	// tag is not queried again
+	block, err = s.finalityClient.LatestBlock()
+	s.Nil(err)
+	s.Equal(big.NewInt(100), block)
+}
+
+func (s *FinalityClientTestSuite) Test_LatestBlock_ReturnsTransientError() {
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(nil, errors.New("connection refused"))
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(big.NewInt(105), nil)
+
+	_, err := s.finalityClient.LatestBlock()
+	s.NotNil(err)
+
+	block, err := s.finalityClient.LatestBlock()
+	s.Nil(err)
+	s.Equal(big.NewInt(105), block)
+}
+
+func (s *FinalityClientTestSuite) Test_LatestBlock_ReturnsOtherRPCError() {
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(nil, rpcError{code: -32000})
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(big.NewInt(105), nil)
+
+	_, err := s.finalityClient.LatestBlock()
+	s.NotNil(err)
+
+	_, err = s.finalityClient.LatestBlock()
+	s.NotNil(err)
+
+	block, err := s.finalityClient.LatestBlock()
+	s.Nil(err)
+	s.Equal(big.NewInt(105), block)
+}
+
+func (s *FinalityClientTestSuite) Test_LatestBlock_FailedLatestBlock() {
+	s.mockClient.EXPECT().TaggedBlock(listener.FinalizedTag).Return(nil, rpcError{code: -32601})
+	s.mockClient.EXPECT().LatestBlock().Return(nil, errors.New("error"))
+
+	_, err := s.finalityClient.LatestBlock()
+
+	s.NotNil(err)
+}
@@ -5,21 +5,48 @@ package listener
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
+// EventHandler fetches events from a block range and returns a function that
+// handles them. Fetching has no side effects, so block ranges can be fetched
+// concurrently while fetched events are still handled in block order.
 type EventHandler interface {
-	HandleEvent(startBlock *big.Int, endBlock *big.Int, msgChan chan []*message.Message) error
+	FetchEvents(ctx context.Context, startBlock *big.Int, endBlock *big.Int) (HandleFunc, error)
 }
 
+// HandleFunc applies side effects of fetched events and sends messages to msgChan
+// until ctx is done
+type HandleFunc func(ctx context.Context, msgChan chan []*message.Message) error
+
 type ChainClient interface {
 	LatestBlock() (*big.Int, error)
+	BlockHash(number *big.Int) (common.Hash, error)
+}
+
+type HeadSubscriber interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+type SubscriptionClient interface {
+	ChainClient
+	HeadSubscriber
+}
+
+type TaggedBlockClient interface {
+	SubscriptionClient
+	TaggedBlock(tag string) (*big.Int, error)
 }
 
 type BlockDeltaMeter interface {
@@ -28,33 +55,71 @@ type BlockDeltaMeter interface {
 
 type BlockStorer interface {
 	StoreBlock(block *big.Int, domainID uint8) error
+	StoreBlockHashes(domainID uint8, hashes []store.BlockHash) error
+	GetBlockHashes(domainID uint8) ([]store.BlockHash, error)
+}
+
+// maxConsecutiveFailures is the number of block ranges in a row the listener
+// fails to process before it stops, so the chain is restarted
+const maxConsecutiveFailures = 20
+
+type MessageRetractor interface {
+	RetractMessages(source uint8, fromBlock *big.Int) ([]*message.Message, error)
+}
+
+type RetractionHandler interface {
+	Retract(msgs []*message.Message)
 }
 
 type EVMListener struct {
 	client        ChainClient
 	eventHandlers []EventHandler
 	metrics       BlockDeltaMeter
+	retractor     MessageRetractor
+	// retractionHandlers are notified of messages retracted after a reorganization
+	retractionHandlers []RetractionHandler
 
 	domainID           uint8
 	blockstore         BlockStorer
 	blockRetryInterval time.Duration
 	blockConfirmations *big.Int
 	blockInterval      *big.Int
+	minBlockInterval   *big.Int
+	maxBlockInterval   *big.Int
+	maxReorgDepth      *big.Int
+	backfillWorkers    int
+	blockHashes        []store.BlockHash
+	headSubscription   *headSubscription
 
 	log zerolog.Logger
 }
 
 // NewEVMListener creates an EVMListener that listens to deposit events on chain
-// and calls event handler when one occurs
+// and calls event handler when one occurs.
+//
+// Hashes of processed blocks up to maxReorgDepth blocks behind the
+// latest processed block are tracked to detect chain reorganizations.
+//
+// Block range starts at blockInterval blocks and grows up to maxBlockInterval
+// while the listener is far behind head and shrinks down to minBlockInterval
+// when the provider rejects the range.
+//
+// If backfillWorkers is greater than one, up to backfillWorkers confirmed block
+// ranges are fetched concurrently while the listener is catching up.
 func NewEVMListener(
 	client ChainClient,
 	eventHandlers []EventHandler,
 	blockstore BlockStorer,
+	retractor MessageRetractor,
 	metrics BlockDeltaMeter,
 	domainID uint8,
 	blockRetryInterval time.Duration,
 	blockConfirmations *big.Int,
-	blockInterval *big.Int) *EVMListener {
+	blockInterval *big.Int,
+	minBlockInterval *big.Int,
+	maxBlockInterval *big.Int,
+	maxReorgDepth *big.Int,
+	backfillWorkers int) *EVMListener {
 	logger := log.With().Uint8("domainID", domainID).Logger()
 	return &EVMListener{
 		log:                logger,
@@ -62,59 +127,180 @@ func NewEVMListener(
 		metrics:            metrics,
 		eventHandlers:      eventHandlers,
 		blockstore:         blockstore,
+		retractor:          retractor,
 		domainID:           domainID,
 		blockRetryInterval: blockRetryInterval,
 		blockConfirmations: blockConfirmations,
 		blockInterval:      blockInterval,
+		minBlockInterval:   minBlockInterval,
+		maxBlockInterval:   maxBlockInterval,
+		maxReorgDepth:      maxReorgDepth,
+		backfillWorkers:    backfillWorkers,
 	}
 }
 
+// NewEVMListenerWithSubscription creates an EVMListener that subscribes to new heads
+// and processes blocks as soon as they are received instead of waiting for
+// the next block retry interval.
+//
+// It falls back to polling while the subscription is down or if the endpoint
+// doesn't support subscriptions (e.g. HTTP endpoints).
+func NewEVMListenerWithSubscription(
+	client SubscriptionClient,
+	eventHandlers []EventHandler,
+	blockstore BlockStorer,
+	retractor MessageRetractor,
+	metrics BlockDeltaMeter,
+	domainID uint8,
+	blockRetryInterval time.Duration,
+	blockConfirmations *big.Int,
+	blockInterval *big.Int,
+	minBlockInterval *big.Int,
+	maxBlockInterval *big.Int,
+	maxReorgDepth *big.Int,
+	backfillWorkers int) *EVMListener {
+	listener := NewEVMListener(client, eventHandlers, blockstore, retractor, metrics, domainID, blockRetryInterval, blockConfirmations, blockInterval, minBlockInterval, maxBlockInterval, maxReorgDepth, backfillWorkers)
+	listener.headSubscription = newHeadSubscription(client, blockRetryInterval, listener.log)
+	return listener
+}
+
+// RegisterRetractionHandler registers handler that is notified of messages
+// retracted because blocks they were read from were reorganized
+func (l *EVMListener) RegisterRetractionHandler(handler RetractionHandler) {
+	l.retractionHandlers = append(l.retractionHandlers, handler)
+}
+
 // ListenToEvents goes block by block of a network and executes event handlers that are
-// configured for the listener.
+// configured for the listener. Listener stops and reports the error to errChn once
+// it fails to process blocks maxConsecutiveFailures times in a row.
 func (l *EVMListener) ListenToEvents(ctx context.Context, startBlock *big.Int, msgChan chan []*message.Message, errChn chan<- error) {
-	endBlock := big.NewInt(0)
-loop:
+	hashes, err := l.blockstore.GetBlockHashes(l.domainID)
+	if err != nil {
+		l.log.Warn().Err(err).Msg("Unable to load processed block hashes")
+		hashes = []store.BlockHash{}
+	}
+	l.blockHashes = hashes
+	if l.headSubscription != nil {
+		defer l.headSubscription.close()
+	}
+
+	failures := 0
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			head, err := l.client.LatestBlock()
-			if err != nil {
-				l.log.Error().Err(err).Msg("Unable to get latest block")
-				time.Sleep(l.blockRetryInterval)
+			startBlock, err = l.poll(ctx, startBlock, msgChan)
+			if err == nil {
+				failures = 0
 				continue
 			}
-			if startBlock == nil {
-				startBlock = big.NewInt(head.Int64())
-			}
-			endBlock.Add(startBlock, l.blockInterval)
 
-			// Sleep if the difference is less than needed block confirmations; (latest - current) < BlockDelay
-			if new(big.Int).Sub(head, endBlock).Cmp(l.blockConfirmations) == -1 {
-				time.Sleep(l.blockRetryInterval)
-				continue
+			failures++
+			if failures >= maxConsecutiveFailures && ctx.Err() == nil {
+				select {
+				case errChn <- fmt.Errorf("listener failed %d times in a row: %w", failures, err):
+				case <-ctx.Done():
+				}
+				return
 			}
+		}
+	}
+}
 
-			l.metrics.TrackBlockDelta(l.domainID, head, endBlock)
-			l.log.Debug().Msgf("Fetching evm events for block range %s-%s", startBlock, endBlock)
+// poll processes the next block range starting at startBlock and returns
+// the block from which processing should continue
+func (l *EVMListener) poll(ctx context.Context, startBlock *big.Int, msgChan chan []*message.Message) (*big.Int, error) {
+	head, err := l.client.LatestBlock()
+	if err != nil {
+		l.log.Error().Err(err).Msg("Unable to get latest block")
+		l.waitForBlock(ctx)
+		return startBlock, err
+	}
+	if startBlock == nil {
+		startBlock = big.NewInt(head.Int64())
+	}
+	endBlock := l.rangeEnd(startBlock, head)
 
-			for _, handler := range l.eventHandlers {
-				err := handler.HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan)
-				if err != nil {
-					l.log.Warn().Err(err).Msgf("Unable to handle events")
-					continue loop
-				}
+	// Sleep if the difference is less than needed block confirmations; (latest - current) < BlockDelay
+	if new(big.Int).Sub(head, endBlock).Cmp(l.blockConfirmations) == -1 {
+		l.waitForBlock(ctx)
+		return startBlock, nil
+	}
 
-			}
+	forkBlock, err := l.detectReorg(startBlock, head)
+	if err != nil {
+		l.log.Warn().Err(err).Msg("Unable to check for chain reorganization")
+		l.waitForBlock(ctx)
+		return startBlock, err
+	}
+	if forkBlock != nil {
+		err = l.rewind(forkBlock)
+		if err != nil {
+			l.log.Error().Err(err).Msgf("Unable to rewind to block %s", forkBlock)
+			l.waitForBlock(ctx)
+			return startBlock, err
+		}
+		return forkBlock, nil
+	}
 
-			//Write to block store. Not a critical operation, no need to retry
-			err = l.blockstore.StoreBlock(endBlock, l.domainID)
-			if err != nil {
-				l.log.Error().Str("block", endBlock.String()).Err(err).Msg("Failed to write latest block to blockstore")
-			}
+	ranges := l.backfillRanges(startBlock, head)
+	if len(ranges) > 1 {
+		return l.backfill(ctx, ranges, head, msgChan)
+	}
+
+	lastBlock := new(big.Int).Sub(endBlock, big.NewInt(1))
+	hash, err := l.client.BlockHash(lastBlock)
+	if err != nil {
+		l.log.Warn().Err(err).Msgf("Unable to get hash of block %s", lastBlock)
+		l.waitForBlock(ctx)
+		return startBlock, err
+	}
+
+	l.metrics.TrackBlockDelta(l.domainID, head, endBlock)
+	l.log.Debug().Msgf("Fetching evm events for block range %s-%s", startBlock, endBlock)
+
+	handles, err := l.fetchEvents(ctx, startBlock, lastBlock)
+	if err != nil {
+		l.log.Warn().Err(err).Msgf("Unable to fetch events")
+		if isRangeLimitError(err) {
+			l.shrinkBlockInterval()
+		}
+		return startBlock, err
+	}
+	for _, handle := range handles {
+		err := handle(ctx, msgChan)
+		if err != nil {
+			l.log.Warn().Err(err).Msgf("Unable to handle events")
+			return startBlock, err
+		}
+	}
+
+	l.trackBlockHash(lastBlock, hash)
+	err = l.blockstore.StoreBlockHashes(l.domainID, l.blockHashes)
+	if err != nil {
+		l.log.Error().Str("block", lastBlock.String()).Err(err).Msg("Failed to write block hashes to blockstore")
+	}
+
+	//Write to block store. Not a critical operation, no need to retry
+	err = l.blockstore.StoreBlock(endBlock, l.domainID)
+	if err != nil {
+		l.log.Error().Str("block", endBlock.String()).Err(err).Msg("Failed to write latest block to blockstore")
+	}
+
+	l.growBlockInterval(head, endBlock)
+	return new(big.Int).Set(endBlock), nil
+}
 
-			startBlock.Add(startBlock, l.blockInterval)
+// fetchEvents fetches events of all event handlers from the block range
+func (l *EVMListener) fetchEvents(ctx context.Context, startBlock *big.Int, endBlock *big.Int) ([]HandleFunc, error) {
+	handles := make([]HandleFunc, 0, len(l.eventHandlers))
+	for _, handler := range l.eventHandlers {
+		handle, err := handler.FetchEvents(ctx, startBlock, endBlock)
+		if err != nil {
+			return nil, err
 		}
+		handles = append(handles, handle)
 	}
+	return handles, nil
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
 	mock_listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener/mock"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -20,10 +22,26 @@ type ListenerTestSuite struct {
 	mockClient          *mock_listener.MockChainClient
 	mockEventHandler    *mock_listener.MockEventHandler
 	mockBlockStorer     *mock_listener.MockBlockStorer
+	mockRetractor       *mock_listener.MockMessageRetractor
 	mockBlockDeltaMeter *mock_listener.MockBlockDeltaMeter
 	domainID            uint8
 }
 
+func handleNothing(ctx context.Context, msgChan chan []*message.Message) error {
+	return nil
+}
+
+func handleMessages(msgs []*message.Message) listener.HandleFunc {
+	return func(ctx context.Context, msgChan chan []*message.Message) error {
+		select {
+		case msgChan <- msgs:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 func TestRunTestSuite(t *testing.T) {
 	suite.Run(t, new(ListenerTestSuite))
 }
@@ -34,19 +52,26 @@ func (s *ListenerTestSuite) SetupTest() {
 	s.mockClient = mock_listener.NewMockChainClient(ctrl)
 	s.mockEventHandler = mock_listener.NewMockEventHandler(ctrl)
 	s.mockBlockStorer = mock_listener.NewMockBlockStorer(ctrl)
+	s.mockRetractor = mock_listener.NewMockMessageRetractor(ctrl)
 	s.mockBlockDeltaMeter = mock_listener.NewMockBlockDeltaMeter(ctrl)
 	s.listener = listener.NewEVMListener(
 		s.mockClient,
 		[]listener.EventHandler{s.mockEventHandler, s.mockEventHandler},
 		s.mockBlockStorer,
+		s.mockRetractor,
 		s.mockBlockDeltaMeter,
 		s.domainID,
 		time.Millisecond*75,
 		big.NewInt(5),
-		big.NewInt(5))
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(20),
+		1)
 }
 
 func (s *ListenerTestSuite) Test_ListenToEvents_RetriesIfBlockUnavailable() {
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
 	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(0), fmt.Errorf("error"))
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -58,6 +83,7 @@ func (s *ListenerTestSuite) Test_ListenToEvents_RetriesIfBlockUnavailable() {
 }
 
 func (s *ListenerTestSuite) Test_ListenToEvents_SleepsIfBlockTooNew() {
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
 	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(109), nil)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -74,16 +100,20 @@ func (s *ListenerTestSuite) Test_ListenToEvents_RetriesInCaseOfHandlerFailure()
 	endBlock := big.NewInt(105)
 	head := big.NewInt(110)
 	msgChan := make(chan []*message.Message, 2)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
 
 	// First pass
 	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
 	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, endBlock)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(fmt.Errorf("error"))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(nil, fmt.Errorf("error"))
 	// Second pass
 	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
 	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, endBlock)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{{Number: big.NewInt(104), Hash: common.Hash{1}}}).Return(nil)
 	s.mockBlockStorer.EXPECT().StoreBlock(endBlock, s.domainID).Return(nil)
 	// third pass
 	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
@@ -96,18 +126,46 @@ func (s *ListenerTestSuite) Test_ListenToEvents_RetriesInCaseOfHandlerFailure()
 	cancel()
 }
 
+func (s *ListenerTestSuite) Test_ListenToEvents_ReportsErrorAfterConsecutiveFailures() {
+	startBlock := big.NewInt(100)
+	head := big.NewInt(110)
+	msgChan := make(chan []*message.Message, 2)
+	errChn := make(chan error, 1)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil).Times(20)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil).Times(20)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, big.NewInt(105)).Times(20)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, big.NewInt(104)).Return(nil, fmt.Errorf("error")).Times(20)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.listener.ListenToEvents(context.Background(), startBlock, msgChan, errChn)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.Fail("listener didn't stop")
+	}
+	s.NotNil(<-errChn)
+}
+
 func (s *ListenerTestSuite) Test_ListenToEvents_StoresBlockIfEventHandlingSuccessful() {
 	startBlock := big.NewInt(100)
 	endBlock := big.NewInt(105)
 	head := big.NewInt(110)
 	msgChan := make(chan []*message.Message, 2)
 
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
 	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
 	// prevent infinite runs
 	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
 	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, endBlock)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
 	s.mockBlockStorer.EXPECT().StoreBlock(endBlock, s.domainID).Return(nil)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -124,12 +182,15 @@ func (s *ListenerTestSuite) Test_ListenToEvents_IgnoresBlocStorerError() {
 	head := big.NewInt(110)
 	msgChan := make(chan []*message.Message, 2)
 
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
 	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
 	// prevent infinite runs
 	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
 	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, endBlock)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
 	s.mockBlockStorer.EXPECT().StoreBlock(endBlock, s.domainID).Return(fmt.Errorf("error"))
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -147,14 +208,17 @@ func (s *ListenerTestSuite) Test_ListenToEvents_UsesHeadAsStartBlockIfNilPassed(
 	newHead := big.NewInt(120)
 	msgChan := make(chan []*message.Message, 2)
 
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
 	s.mockClient.EXPECT().LatestBlock().Return(oldHead, nil)
 	s.mockClient.EXPECT().LatestBlock().Return(newHead, nil)
 	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(65), nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(114)).Return(common.Hash{1}, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
 
 	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), big.NewInt(120), endBlock)
 
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
-	s.mockEventHandler.EXPECT().HandleEvent(startBlock, new(big.Int).Sub(endBlock, big.NewInt(1)), msgChan).Return(nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), startBlock, new(big.Int).Sub(endBlock, big.NewInt(1))).Return(handleNothing, nil)
 	s.mockBlockStorer.EXPECT().StoreBlock(endBlock, s.domainID).Return(nil)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -164,3 +228,223 @@ func (s *ListenerTestSuite) Test_ListenToEvents_UsesHeadAsStartBlockIfNilPassed(
 	time.Sleep(time.Millisecond * 100)
 	cancel()
 }
+
+func (s *ListenerTestSuite) Test_ListenToEvents_RewindsToForkBlockOnReorg() {
+	head := big.NewInt(110)
+	msgChan := make(chan []*message.Message, 2)
+
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{
+		{Number: big.NewInt(94), Hash: common.Hash{1}},
+		{Number: big.NewInt(99), Hash: common.Hash{2}},
+	}, nil)
+	// First pass detects reorg after block 94
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(99)).Return(common.Hash{3}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(94)).Return(common.Hash{1}, nil)
+	s.mockRetractor.EXPECT().RetractMessages(s.domainID, big.NewInt(95)).Return([]*message.Message{{DepositNonce: 1}}, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{{Number: big.NewInt(94), Hash: common.Hash{1}}}).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(95), s.domainID).Return(nil)
+	// Second pass processes range again
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(94)).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(99)).Return(common.Hash{3}, nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, big.NewInt(100))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(95), big.NewInt(99)).Return(handleNothing, nil).Times(2)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{
+		{Number: big.NewInt(94), Hash: common.Hash{1}},
+		{Number: big.NewInt(99), Hash: common.Hash{3}},
+	}).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(100), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
+
+func (s *ListenerTestSuite) Test_ListenToEvents_RewindsToOldestTrackedBlockIfForkNotFound() {
+	head := big.NewInt(110)
+	msgChan := make(chan []*message.Message, 2)
+
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{
+		{Number: big.NewInt(99), Hash: common.Hash{2}},
+	}, nil)
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(99)).Return(common.Hash{3}, nil)
+	s.mockRetractor.EXPECT().RetractMessages(s.domainID, big.NewInt(99)).Return([]*message.Message{}, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{}).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(99), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(99), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
+
+func (s *ListenerTestSuite) Test_ListenToEvents_RewindsNoFurtherThanMaxReorgDepth() {
+	head := big.NewInt(110)
+	msgChan := make(chan []*message.Message, 2)
+
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{
+		{Number: big.NewInt(70), Hash: common.Hash{1}},
+		{Number: big.NewInt(80), Hash: common.Hash{2}},
+		{Number: big.NewInt(99), Hash: common.Hash{3}},
+	}, nil)
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(99)).Return(common.Hash{4}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(80)).Return(common.Hash{5}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(70)).Return(common.Hash{6}, nil)
+	s.mockRetractor.EXPECT().RetractMessages(s.domainID, big.NewInt(90)).Return([]*message.Message{}, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{}).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(90), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(90), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
+
+func (s *ListenerTestSuite) Test_ListenToEvents_NotifiesRetractionHandlers() {
+	head := big.NewInt(110)
+	msgChan := make(chan []*message.Message, 2)
+	retracted := []*message.Message{{DepositNonce: 1}}
+	retractionHandler := mock_listener.NewMockRetractionHandler(gomock.NewController(s.T()))
+	s.listener.RegisterRetractionHandler(retractionHandler)
+
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{
+		{Number: big.NewInt(99), Hash: common.Hash{2}},
+	}, nil)
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(99)).Return(common.Hash{3}, nil)
+	s.mockRetractor.EXPECT().RetractMessages(s.domainID, big.NewInt(99)).Return(retracted, nil)
+	retractionHandler.EXPECT().Retract(retracted)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, []store.BlockHash{}).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(99), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(99), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
+
+func (s *ListenerTestSuite) Test_ListenToEvents_DoesNotRewindIfRetractFails() {
+	head := big.NewInt(110)
+	msgChan := make(chan []*message.Message, 2)
+
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{
+		{Number: big.NewInt(99), Hash: common.Hash{2}},
+	}, nil)
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(99)).Return(common.Hash{3}, nil)
+	s.mockRetractor.EXPECT().RetractMessages(s.domainID, big.NewInt(99)).Return(nil, fmt.Errorf("error"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
+
+func (s *ListenerTestSuite) Test_ListenToEvents_ShrinksBlockRangeOnRangeLimitError() {
+	s.listener = listener.NewEVMListener(
+		s.mockClient,
+		[]listener.EventHandler{s.mockEventHandler, s.mockEventHandler},
+		s.mockBlockStorer,
+		s.mockRetractor,
+		s.mockBlockDeltaMeter,
+		s.domainID,
+		time.Millisecond*75,
+		big.NewInt(5),
+		big.NewInt(4),
+		big.NewInt(2),
+		big.NewInt(4),
+		big.NewInt(10),
+		1)
+	head := big.NewInt(200)
+	msgChan := make(chan []*message.Message, 2)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+
+	// First pass
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(103)).Return(common.Hash{1}, nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, big.NewInt(104))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(100), big.NewInt(103)).Return(nil, fmt.Errorf("query returned more than 10000 results"))
+	// Second pass
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(101)).Return(common.Hash{1}, nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, big.NewInt(102))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(100), big.NewInt(101)).Return(handleNothing, nil).Times(2)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(102), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
+
+func (s *ListenerTestSuite) Test_ListenToEvents_GrowsBlockRangeIfFarBehindHead() {
+	s.listener = listener.NewEVMListener(
+		s.mockClient,
+		[]listener.EventHandler{s.mockEventHandler},
+		s.mockBlockStorer,
+		s.mockRetractor,
+		s.mockBlockDeltaMeter,
+		s.domainID,
+		time.Millisecond*75,
+		big.NewInt(5),
+		big.NewInt(4),
+		big.NewInt(4),
+		big.NewInt(8),
+		big.NewInt(10),
+		1)
+	head := big.NewInt(200)
+	msgChan := make(chan []*message.Message, 2)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+
+	// First pass
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(103)).Return(common.Hash{1}, nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, big.NewInt(104))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(100), big.NewInt(103)).Return(handleNothing, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(104), s.domainID).Return(nil)
+	// Second pass
+	s.mockClient.EXPECT().LatestBlock().Return(head, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(109)).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(103)).Return(common.Hash{1}, nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(uint8(1), head, big.NewInt(110))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(104), big.NewInt(109)).Return(handleNothing, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(110), s.domainID).Return(nil)
+	// prevent infinite runs
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/listener/deposit-finder.go
+
+// Package mock_listener is a generated GoMock package.
+package mock_listener
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDepositCounter is a mock of DepositCounter interface.
+type MockDepositCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositCounterMockRecorder
+}
+
+// MockDepositCounterMockRecorder is the mock recorder for MockDepositCounter.
+type MockDepositCounterMockRecorder struct {
+	mock *MockDepositCounter
+}
+
+// NewMockDepositCounter creates a new mock instance.
+func NewMockDepositCounter(ctrl *gomock.Controller) *MockDepositCounter {
+	mock := &MockDepositCounter{ctrl: ctrl}
+	mock.recorder = &MockDepositCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositCounter) EXPECT() *MockDepositCounterMockRecorder {
+	return m.recorder
+}
+
+// DepositCount mocks base method.
+func (m *MockDepositCounter) DepositCount(destinationDomainID uint8) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DepositCount", destinationDomainID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DepositCount indicates an expected call of DepositCount.
+func (mr *MockDepositCounterMockRecorder) DepositCount(destinationDomainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositCount", reflect.TypeOf((*MockDepositCounter)(nil).DepositCount), destinationDomainID)
+}
@@ -91,3 +91,75 @@ func (mr *MockDepositHandlerMockRecorder) HandleDeposit(sourceID, destID, nonce,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeposit", reflect.TypeOf((*MockDepositHandler)(nil).HandleDeposit), sourceID, destID, nonce, resourceID, calldata, handlerResponse)
 }
+
+// MockMessageStorer is a mock of MessageStorer interface.
+type MockMessageStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageStorerMockRecorder
+}
+
+// MockMessageStorerMockRecorder is the mock recorder for MockMessageStorer.
+type MockMessageStorerMockRecorder struct {
+	mock *MockMessageStorer
+}
+
+// NewMockMessageStorer creates a new mock instance.
+func NewMockMessageStorer(ctrl *gomock.Controller) *MockMessageStorer {
+	mock := &MockMessageStorer{ctrl: ctrl}
+	mock.recorder = &MockMessageStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageStorer) EXPECT() *MockMessageStorerMockRecorder {
+	return m.recorder
+}
+
+// StoreMessages mocks base method.
+func (m *MockMessageStorer) StoreMessages(block *big.Int, msgs []*message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreMessages", block, msgs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreMessages indicates an expected call of StoreMessages.
+func (mr *MockMessageStorerMockRecorder) StoreMessages(block, msgs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreMessages", reflect.TypeOf((*MockMessageStorer)(nil).StoreMessages), block, msgs)
+}
+
+// MockDepositTracker is a mock of DepositTracker interface.
+type MockDepositTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositTrackerMockRecorder
+}
+
+// MockDepositTrackerMockRecorder is the mock recorder for MockDepositTracker.
+type MockDepositTrackerMockRecorder struct {
+	mock *MockDepositTracker
+}
+
+// NewMockDepositTracker creates a new mock instance.
+func NewMockDepositTracker(ctrl *gomock.Controller) *MockDepositTracker {
+	mock := &MockDepositTracker{ctrl: ctrl}
+	mock.recorder = &MockDepositTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositTracker) EXPECT() *MockDepositTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackDeposit mocks base method.
+func (m_2 *MockDepositTracker) TrackDeposit(m *message.Message) {
+	m_2.ctrl.T.Helper()
+	m_2.ctrl.Call(m_2, "TrackDeposit", m)
+}
+
+// TrackDeposit indicates an expected call of TrackDeposit.
+func (mr *MockDepositTrackerMockRecorder) TrackDeposit(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackDeposit", reflect.TypeOf((*MockDepositTracker)(nil).TrackDeposit), m)
+}
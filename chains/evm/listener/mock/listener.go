@@ -5,10 +5,16 @@
 package mock_listener
 
 import (
+	context "context"
 	big "math/big"
 	reflect "reflect"
 
+	listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener"
 	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	store "github.com/ChainSafe/chainbridge-core/store"
+	ethereum "github.com/ethereum/go-ethereum"
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,18 +41,19 @@ func (m *MockEventHandler) EXPECT() *MockEventHandlerMockRecorder {
 	return m.recorder
 }
 
-// HandleEvent mocks base method.
-func (m *MockEventHandler) HandleEvent(startBlock, endBlock *big.Int, msgChan chan []*message.Message) error {
+// FetchEvents mocks base method.
+func (m *MockEventHandler) FetchEvents(ctx context.Context, startBlock, endBlock *big.Int) (listener.HandleFunc, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleEvent", startBlock, endBlock, msgChan)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FetchEvents", ctx, startBlock, endBlock)
+	ret0, _ := ret[0].(listener.HandleFunc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// HandleEvent indicates an expected call of HandleEvent.
-func (mr *MockEventHandlerMockRecorder) HandleEvent(startBlock, endBlock, msgChan interface{}) *gomock.Call {
+// FetchEvents indicates an expected call of FetchEvents.
+func (mr *MockEventHandlerMockRecorder) FetchEvents(ctx, startBlock, endBlock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockEventHandler)(nil).HandleEvent), startBlock, endBlock, msgChan)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEvents", reflect.TypeOf((*MockEventHandler)(nil).FetchEvents), ctx, startBlock, endBlock)
 }
 
 // MockChainClient is a mock of ChainClient interface.
@@ -72,6 +79,21 @@ func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
 	return m.recorder
 }
 
+// BlockHash mocks base method.
+func (m *MockChainClient) BlockHash(number *big.Int) (common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockHash", number)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockHash indicates an expected call of BlockHash.
+func (mr *MockChainClientMockRecorder) BlockHash(number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockHash", reflect.TypeOf((*MockChainClient)(nil).BlockHash), number)
+}
+
 // LatestBlock mocks base method.
 func (m *MockChainClient) LatestBlock() (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +109,195 @@ func (mr *MockChainClientMockRecorder) LatestBlock() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockChainClient)(nil).LatestBlock))
 }
 
+// MockHeadSubscriber is a mock of HeadSubscriber interface.
+type MockHeadSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeadSubscriberMockRecorder
+}
+
+// MockHeadSubscriberMockRecorder is the mock recorder for MockHeadSubscriber.
+type MockHeadSubscriberMockRecorder struct {
+	mock *MockHeadSubscriber
+}
+
+// NewMockHeadSubscriber creates a new mock instance.
+func NewMockHeadSubscriber(ctrl *gomock.Controller) *MockHeadSubscriber {
+	mock := &MockHeadSubscriber{ctrl: ctrl}
+	mock.recorder = &MockHeadSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeadSubscriber) EXPECT() *MockHeadSubscriberMockRecorder {
+	return m.recorder
+}
+
+// SubscribeNewHead mocks base method.
+func (m *MockHeadSubscriber) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewHead", ctx, ch)
+	ret0, _ := ret[0].(ethereum.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewHead indicates an expected call of SubscribeNewHead.
+func (mr *MockHeadSubscriberMockRecorder) SubscribeNewHead(ctx, ch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewHead", reflect.TypeOf((*MockHeadSubscriber)(nil).SubscribeNewHead), ctx, ch)
+}
+
+// MockSubscriptionClient is a mock of SubscriptionClient interface.
+type MockSubscriptionClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionClientMockRecorder
+}
+
+// MockSubscriptionClientMockRecorder is the mock recorder for MockSubscriptionClient.
+type MockSubscriptionClientMockRecorder struct {
+	mock *MockSubscriptionClient
+}
+
+// NewMockSubscriptionClient creates a new mock instance.
+func NewMockSubscriptionClient(ctrl *gomock.Controller) *MockSubscriptionClient {
+	mock := &MockSubscriptionClient{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionClient) EXPECT() *MockSubscriptionClientMockRecorder {
+	return m.recorder
+}
+
+// BlockHash mocks base method.
+func (m *MockSubscriptionClient) BlockHash(number *big.Int) (common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockHash", number)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockHash indicates an expected call of BlockHash.
+func (mr *MockSubscriptionClientMockRecorder) BlockHash(number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockHash", reflect.TypeOf((*MockSubscriptionClient)(nil).BlockHash), number)
+}
+
+// LatestBlock mocks base method.
+func (m *MockSubscriptionClient) LatestBlock() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockSubscriptionClientMockRecorder) LatestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockSubscriptionClient)(nil).LatestBlock))
+}
+
+// SubscribeNewHead mocks base method.
+func (m *MockSubscriptionClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewHead", ctx, ch)
+	ret0, _ := ret[0].(ethereum.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewHead indicates an expected call of SubscribeNewHead.
+func (mr *MockSubscriptionClientMockRecorder) SubscribeNewHead(ctx, ch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewHead", reflect.TypeOf((*MockSubscriptionClient)(nil).SubscribeNewHead), ctx, ch)
+}
+
+// MockTaggedBlockClient is a mock of TaggedBlockClient interface.
+type MockTaggedBlockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaggedBlockClientMockRecorder
+}
+
+// MockTaggedBlockClientMockRecorder is the mock recorder for MockTaggedBlockClient.
+type MockTaggedBlockClientMockRecorder struct {
+	mock *MockTaggedBlockClient
+}
+
+// NewMockTaggedBlockClient creates a new mock instance.
+func NewMockTaggedBlockClient(ctrl *gomock.Controller) *MockTaggedBlockClient {
+	mock := &MockTaggedBlockClient{ctrl: ctrl}
+	mock.recorder = &MockTaggedBlockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaggedBlockClient) EXPECT() *MockTaggedBlockClientMockRecorder {
+	return m.recorder
+}
+
+// BlockHash mocks base method.
+func (m *MockTaggedBlockClient) BlockHash(number *big.Int) (common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockHash", number)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockHash indicates an expected call of BlockHash.
+func (mr *MockTaggedBlockClientMockRecorder) BlockHash(number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockHash", reflect.TypeOf((*MockTaggedBlockClient)(nil).BlockHash), number)
+}
+
+// LatestBlock mocks base method.
+func (m *MockTaggedBlockClient) LatestBlock() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockTaggedBlockClientMockRecorder) LatestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockTaggedBlockClient)(nil).LatestBlock))
+}
+
+// SubscribeNewHead mocks base method.
+func (m *MockTaggedBlockClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewHead", ctx, ch)
+	ret0, _ := ret[0].(ethereum.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewHead indicates an expected call of SubscribeNewHead.
+func (mr *MockTaggedBlockClientMockRecorder) SubscribeNewHead(ctx, ch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewHead", reflect.TypeOf((*MockTaggedBlockClient)(nil).SubscribeNewHead), ctx, ch)
+}
+
+// TaggedBlock mocks base method.
+func (m *MockTaggedBlockClient) TaggedBlock(tag string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaggedBlock", tag)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaggedBlock indicates an expected call of TaggedBlock.
+func (mr *MockTaggedBlockClientMockRecorder) TaggedBlock(tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaggedBlock", reflect.TypeOf((*MockTaggedBlockClient)(nil).TaggedBlock), tag)
+}
+
 // MockBlockDeltaMeter is a mock of BlockDeltaMeter interface.
 type MockBlockDeltaMeter struct {
 	ctrl     *gomock.Controller
@@ -145,6 +356,21 @@ func (m *MockBlockStorer) EXPECT() *MockBlockStorerMockRecorder {
 	return m.recorder
 }
 
+// GetBlockHashes mocks base method.
+func (m *MockBlockStorer) GetBlockHashes(domainID uint8) ([]store.BlockHash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHashes", domainID)
+	ret0, _ := ret[0].([]store.BlockHash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHashes indicates an expected call of GetBlockHashes.
+func (mr *MockBlockStorerMockRecorder) GetBlockHashes(domainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHashes", reflect.TypeOf((*MockBlockStorer)(nil).GetBlockHashes), domainID)
+}
+
 // StoreBlock mocks base method.
 func (m *MockBlockStorer) StoreBlock(block *big.Int, domainID uint8) error {
 	m.ctrl.T.Helper()
@@ -158,3 +384,90 @@ func (mr *MockBlockStorerMockRecorder) StoreBlock(block, domainID interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreBlock", reflect.TypeOf((*MockBlockStorer)(nil).StoreBlock), block, domainID)
 }
+
+// StoreBlockHashes mocks base method.
+func (m *MockBlockStorer) StoreBlockHashes(domainID uint8, hashes []store.BlockHash) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreBlockHashes", domainID, hashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreBlockHashes indicates an expected call of StoreBlockHashes.
+func (mr *MockBlockStorerMockRecorder) StoreBlockHashes(domainID, hashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreBlockHashes", reflect.TypeOf((*MockBlockStorer)(nil).StoreBlockHashes), domainID, hashes)
+}
+
+// MockMessageRetractor is a mock of MessageRetractor interface.
+type MockMessageRetractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageRetractorMockRecorder
+}
+
+// MockMessageRetractorMockRecorder is the mock recorder for MockMessageRetractor.
+type MockMessageRetractorMockRecorder struct {
+	mock *MockMessageRetractor
+}
+
+// NewMockMessageRetractor creates a new mock instance.
+func NewMockMessageRetractor(ctrl *gomock.Controller) *MockMessageRetractor {
+	mock := &MockMessageRetractor{ctrl: ctrl}
+	mock.recorder = &MockMessageRetractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageRetractor) EXPECT() *MockMessageRetractorMockRecorder {
+	return m.recorder
+}
+
+// RetractMessages mocks base method.
+func (m *MockMessageRetractor) RetractMessages(source uint8, fromBlock *big.Int) ([]*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetractMessages", source, fromBlock)
+	ret0, _ := ret[0].([]*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetractMessages indicates an expected call of RetractMessages.
+func (mr *MockMessageRetractorMockRecorder) RetractMessages(source, fromBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetractMessages", reflect.TypeOf((*MockMessageRetractor)(nil).RetractMessages), source, fromBlock)
+}
+
+// MockRetractionHandler is a mock of RetractionHandler interface.
+type MockRetractionHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetractionHandlerMockRecorder
+}
+
+// MockRetractionHandlerMockRecorder is the mock recorder for MockRetractionHandler.
+type MockRetractionHandlerMockRecorder struct {
+	mock *MockRetractionHandler
+}
+
+// NewMockRetractionHandler creates a new mock instance.
+func NewMockRetractionHandler(ctrl *gomock.Controller) *MockRetractionHandler {
+	mock := &MockRetractionHandler{ctrl: ctrl}
+	mock.recorder = &MockRetractionHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetractionHandler) EXPECT() *MockRetractionHandlerMockRecorder {
+	return m.recorder
+}
+
+// Retract mocks base method.
+func (m *MockRetractionHandler) Retract(msgs []*message.Message) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Retract", msgs)
+}
+
+// Retract indicates an expected call of Retract.
+func (mr *MockRetractionHandlerMockRecorder) Retract(msgs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retract", reflect.TypeOf((*MockRetractionHandler)(nil).Retract), msgs)
+}
@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/listener/proposal-handler.go
+
+// Package mock_listener is a generated GoMock package.
+package mock_listener
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	events "github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockProposalEventListener is a mock of ProposalEventListener interface.
+type MockProposalEventListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockProposalEventListenerMockRecorder
+}
+
+// MockProposalEventListenerMockRecorder is the mock recorder for MockProposalEventListener.
+type MockProposalEventListenerMockRecorder struct {
+	mock *MockProposalEventListener
+}
+
+// NewMockProposalEventListener creates a new mock instance.
+func NewMockProposalEventListener(ctrl *gomock.Controller) *MockProposalEventListener {
+	mock := &MockProposalEventListener{ctrl: ctrl}
+	mock.recorder = &MockProposalEventListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProposalEventListener) EXPECT() *MockProposalEventListenerMockRecorder {
+	return m.recorder
+}
+
+// FetchProposalEvents mocks base method.
+func (m *MockProposalEventListener) FetchProposalEvents(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) ([]*events.ProposalEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchProposalEvents", ctx, address, startBlock, endBlock)
+	ret0, _ := ret[0].([]*events.ProposalEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchProposalEvents indicates an expected call of FetchProposalEvents.
+func (mr *MockProposalEventListenerMockRecorder) FetchProposalEvents(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchProposalEvents", reflect.TypeOf((*MockProposalEventListener)(nil).FetchProposalEvents), ctx, address, startBlock, endBlock)
+}
+
+// FetchProposalVotes mocks base method.
+func (m *MockProposalEventListener) FetchProposalVotes(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) ([]*events.ProposalVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchProposalVotes", ctx, address, startBlock, endBlock)
+	ret0, _ := ret[0].([]*events.ProposalVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchProposalVotes indicates an expected call of FetchProposalVotes.
+func (mr *MockProposalEventListenerMockRecorder) FetchProposalVotes(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchProposalVotes", reflect.TypeOf((*MockProposalEventListener)(nil).FetchProposalVotes), ctx, address, startBlock, endBlock)
+}
+
+// MockProposalStatusTracker is a mock of ProposalStatusTracker interface.
+type MockProposalStatusTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockProposalStatusTrackerMockRecorder
+}
+
+// MockProposalStatusTrackerMockRecorder is the mock recorder for MockProposalStatusTracker.
+type MockProposalStatusTrackerMockRecorder struct {
+	mock *MockProposalStatusTracker
+}
+
+// NewMockProposalStatusTracker creates a new mock instance.
+func NewMockProposalStatusTracker(ctrl *gomock.Controller) *MockProposalStatusTracker {
+	mock := &MockProposalStatusTracker{ctrl: ctrl}
+	mock.recorder = &MockProposalStatusTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProposalStatusTracker) EXPECT() *MockProposalStatusTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackProposalStatus mocks base method.
+func (m *MockProposalStatusTracker) TrackProposalStatus(source, destination uint8, depositNonce uint64, status uint8) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackProposalStatus", source, destination, depositNonce, status)
+}
+
+// TrackProposalStatus indicates an expected call of TrackProposalStatus.
+func (mr *MockProposalStatusTrackerMockRecorder) TrackProposalStatus(source, destination, depositNonce, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackProposalStatus", reflect.TypeOf((*MockProposalStatusTracker)(nil).TrackProposalStatus), source, destination, depositNonce, status)
+}
+
+// MockProposalVoteTracker is a mock of ProposalVoteTracker interface.
+type MockProposalVoteTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockProposalVoteTrackerMockRecorder
+}
+
+// MockProposalVoteTrackerMockRecorder is the mock recorder for MockProposalVoteTracker.
+type MockProposalVoteTrackerMockRecorder struct {
+	mock *MockProposalVoteTracker
+}
+
+// NewMockProposalVoteTracker creates a new mock instance.
+func NewMockProposalVoteTracker(ctrl *gomock.Controller) *MockProposalVoteTracker {
+	mock := &MockProposalVoteTracker{ctrl: ctrl}
+	mock.recorder = &MockProposalVoteTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProposalVoteTracker) EXPECT() *MockProposalVoteTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackProposalVote mocks base method.
+func (m *MockProposalVoteTracker) TrackProposalVote(source, destination uint8, depositNonce uint64, status uint8) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackProposalVote", source, destination, depositNonce, status)
+}
+
+// TrackProposalVote indicates an expected call of TrackProposalVote.
+func (mr *MockProposalVoteTrackerMockRecorder) TrackProposalVote(source, destination, depositNonce, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackProposalVote", reflect.TypeOf((*MockProposalVoteTracker)(nil).TrackProposalVote), source, destination, depositNonce, status)
+}
@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/listener/relayer-set-handler.go
+
+// Package mock_listener is a generated GoMock package.
+package mock_listener
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	events "github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRelayerSetEventListener is a mock of RelayerSetEventListener interface.
+type MockRelayerSetEventListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerSetEventListenerMockRecorder
+}
+
+// MockRelayerSetEventListenerMockRecorder is the mock recorder for MockRelayerSetEventListener.
+type MockRelayerSetEventListenerMockRecorder struct {
+	mock *MockRelayerSetEventListener
+}
+
+// NewMockRelayerSetEventListener creates a new mock instance.
+func NewMockRelayerSetEventListener(ctrl *gomock.Controller) *MockRelayerSetEventListener {
+	mock := &MockRelayerSetEventListener{ctrl: ctrl}
+	mock.recorder = &MockRelayerSetEventListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerSetEventListener) EXPECT() *MockRelayerSetEventListenerMockRecorder {
+	return m.recorder
+}
+
+// FetchRelayerChanges mocks base method.
+func (m *MockRelayerSetEventListener) FetchRelayerChanges(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) ([]*events.RelayerChanged, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchRelayerChanges", ctx, address, startBlock, endBlock)
+	ret0, _ := ret[0].([]*events.RelayerChanged)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchRelayerChanges indicates an expected call of FetchRelayerChanges.
+func (mr *MockRelayerSetEventListenerMockRecorder) FetchRelayerChanges(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRelayerChanges", reflect.TypeOf((*MockRelayerSetEventListener)(nil).FetchRelayerChanges), ctx, address, startBlock, endBlock)
+}
+
+// FetchThresholdChanges mocks base method.
+func (m *MockRelayerSetEventListener) FetchThresholdChanges(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) ([]*events.ThresholdChanged, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchThresholdChanges", ctx, address, startBlock, endBlock)
+	ret0, _ := ret[0].([]*events.ThresholdChanged)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchThresholdChanges indicates an expected call of FetchThresholdChanges.
+func (mr *MockRelayerSetEventListenerMockRecorder) FetchThresholdChanges(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchThresholdChanges", reflect.TypeOf((*MockRelayerSetEventListener)(nil).FetchThresholdChanges), ctx, address, startBlock, endBlock)
+}
+
+// MockRelayerSetContract is a mock of RelayerSetContract interface.
+type MockRelayerSetContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerSetContractMockRecorder
+}
+
+// MockRelayerSetContractMockRecorder is the mock recorder for MockRelayerSetContract.
+type MockRelayerSetContractMockRecorder struct {
+	mock *MockRelayerSetContract
+}
+
+// NewMockRelayerSetContract creates a new mock instance.
+func NewMockRelayerSetContract(ctrl *gomock.Controller) *MockRelayerSetContract {
+	mock := &MockRelayerSetContract{ctrl: ctrl}
+	mock.recorder = &MockRelayerSetContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerSetContract) EXPECT() *MockRelayerSetContractMockRecorder {
+	return m.recorder
+}
+
+// GetThreshold mocks base method.
+func (m *MockRelayerSetContract) GetThreshold() (uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreshold")
+	ret0, _ := ret[0].(uint8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThreshold indicates an expected call of GetThreshold.
+func (mr *MockRelayerSetContractMockRecorder) GetThreshold() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreshold", reflect.TypeOf((*MockRelayerSetContract)(nil).GetThreshold))
+}
+
+// IsRelayer mocks base method.
+func (m *MockRelayerSetContract) IsRelayer(relayerAddress common.Address) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRelayer", relayerAddress)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRelayer indicates an expected call of IsRelayer.
+func (mr *MockRelayerSetContractMockRecorder) IsRelayer(relayerAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRelayer", reflect.TypeOf((*MockRelayerSetContract)(nil).IsRelayer), relayerAddress)
+}
+
+// MockRelayerMembershipMeter is a mock of RelayerMembershipMeter interface.
+type MockRelayerMembershipMeter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerMembershipMeterMockRecorder
+}
+
+// MockRelayerMembershipMeterMockRecorder is the mock recorder for MockRelayerMembershipMeter.
+type MockRelayerMembershipMeterMockRecorder struct {
+	mock *MockRelayerMembershipMeter
+}
+
+// NewMockRelayerMembershipMeter creates a new mock instance.
+func NewMockRelayerMembershipMeter(ctrl *gomock.Controller) *MockRelayerMembershipMeter {
+	mock := &MockRelayerMembershipMeter{ctrl: ctrl}
+	mock.recorder = &MockRelayerMembershipMeterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerMembershipMeter) EXPECT() *MockRelayerMembershipMeterMockRecorder {
+	return m.recorder
+}
+
+// TrackRelayerMembership mocks base method.
+func (m *MockRelayerMembershipMeter) TrackRelayerMembership(domainID uint8, isRelayer bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackRelayerMembership", domainID, isRelayer)
+}
+
+// TrackRelayerMembership indicates an expected call of TrackRelayerMembership.
+func (mr *MockRelayerMembershipMeterMockRecorder) TrackRelayerMembership(domainID, isRelayer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackRelayerMembership", reflect.TypeOf((*MockRelayerMembershipMeter)(nil).TrackRelayerMembership), domainID, isRelayer)
+}
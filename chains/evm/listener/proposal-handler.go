@@ -0,0 +1,118 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type ProposalEventListener interface {
+	FetchProposalEvents(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) ([]*events.ProposalEvent, error)
+	FetchProposalVotes(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) ([]*events.ProposalVote, error)
+}
+
+type ProposalStatusTracker interface {
+	TrackProposalStatus(source uint8, destination uint8, depositNonce uint64, status uint8)
+}
+
+type ProposalVoteTracker interface {
+	TrackProposalVote(source uint8, destination uint8, depositNonce uint64, status uint8)
+}
+
+type ProposalEventHandler struct {
+	eventListener ProposalEventListener
+	trackers      []ProposalStatusTracker
+
+	bridgeAddress common.Address
+	domainID      uint8
+}
+
+// NewProposalEventHandler creates an instance of ProposalEventHandler that watches
+// proposals on the bridge of the destination domain and notifies registered
+// trackers when proposal status changes
+func NewProposalEventHandler(eventListener ProposalEventListener, bridgeAddress common.Address, domainID uint8) *ProposalEventHandler {
+	return &ProposalEventHandler{
+		eventListener: eventListener,
+		trackers:      make([]ProposalStatusTracker, 0),
+		bridgeAddress: bridgeAddress,
+		domainID:      domainID,
+	}
+}
+
+// RegisterTracker registers tracker notified of every proposal status change
+func (eh *ProposalEventHandler) RegisterTracker(tracker ProposalStatusTracker) {
+	eh.trackers = append(eh.trackers, tracker)
+}
+
+// FetchEvents fetches proposal events from the block range.
+// Returned function notifies trackers of proposal status changes.
+func (eh *ProposalEventHandler) FetchEvents(ctx context.Context, startBlock *big.Int, endBlock *big.Int) (HandleFunc, error) {
+	proposalEvents, err := eh.eventListener.FetchProposalEvents(ctx, eh.bridgeAddress, startBlock, endBlock)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proposal events because of: %w", err)
+	}
+
+	return func(ctx context.Context, msgChan chan []*message.Message) error {
+		for _, e := range proposalEvents {
+			log.Debug().Uint8("source", e.OriginDomainID).Uint64("nonce", e.DepositNonce).Msgf("Proposal status changed to %s", message.StatusMap[e.Status])
+
+			for _, tracker := range eh.trackers {
+				tracker.TrackProposalStatus(e.OriginDomainID, eh.domainID, e.DepositNonce, e.Status)
+			}
+		}
+		return nil
+	}, nil
+}
+
+type ProposalVoteHandler struct {
+	eventListener ProposalEventListener
+	trackers      []ProposalVoteTracker
+
+	bridgeAddress common.Address
+	domainID      uint8
+}
+
+// NewProposalVoteHandler creates an instance of ProposalVoteHandler that watches
+// votes on proposals on the bridge of the destination domain and notifies
+// registered trackers of every vote
+func NewProposalVoteHandler(eventListener ProposalEventListener, bridgeAddress common.Address, domainID uint8) *ProposalVoteHandler {
+	return &ProposalVoteHandler{
+		eventListener: eventListener,
+		trackers:      make([]ProposalVoteTracker, 0),
+		bridgeAddress: bridgeAddress,
+		domainID:      domainID,
+	}
+}
+
+// RegisterTracker registers tracker notified of every proposal vote
+func (eh *ProposalVoteHandler) RegisterTracker(tracker ProposalVoteTracker) {
+	eh.trackers = append(eh.trackers, tracker)
+}
+
+// FetchEvents fetches proposal votes from the block range.
+// Returned function notifies trackers of every vote.
+func (eh *ProposalVoteHandler) FetchEvents(ctx context.Context, startBlock *big.Int, endBlock *big.Int) (HandleFunc, error) {
+	votes, err := eh.eventListener.FetchProposalVotes(ctx, eh.bridgeAddress, startBlock, endBlock)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proposal votes because of: %w", err)
+	}
+
+	return func(ctx context.Context, msgChan chan []*message.Message) error {
+		for _, v := range votes {
+			log.Debug().Uint8("source", v.OriginDomainID).Uint64("nonce", v.DepositNonce).Msgf("Proposal voted on, status %s", message.StatusMap[v.Status])
+
+			for _, tracker := range eh.trackers {
+				tracker.TrackProposalVote(v.OriginDomainID, eh.domainID, v.DepositNonce, v.Status)
+			}
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,89 @@
+package listener_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	mock_listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProposalHandlerTestSuite struct {
+	suite.Suite
+	proposalEventHandler *listener.ProposalEventHandler
+	proposalVoteHandler  *listener.ProposalVoteHandler
+	mockEventListener    *mock_listener.MockProposalEventListener
+	mockStatusTracker    *mock_listener.MockProposalStatusTracker
+	mockVoteTracker      *mock_listener.MockProposalVoteTracker
+	domainID             uint8
+}
+
+func TestRunProposalHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalHandlerTestSuite))
+}
+
+func (s *ProposalHandlerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.domainID = 2
+	s.mockEventListener = mock_listener.NewMockProposalEventListener(ctrl)
+	s.mockStatusTracker = mock_listener.NewMockProposalStatusTracker(ctrl)
+	s.mockVoteTracker = mock_listener.NewMockProposalVoteTracker(ctrl)
+	s.proposalEventHandler = listener.NewProposalEventHandler(s.mockEventListener, common.Address{}, s.domainID)
+	s.proposalEventHandler.RegisterTracker(s.mockStatusTracker)
+	s.proposalVoteHandler = listener.NewProposalVoteHandler(s.mockEventListener, common.Address{}, s.domainID)
+	s.proposalVoteHandler.RegisterTracker(s.mockVoteTracker)
+}
+
+func (s *ProposalHandlerTestSuite) Test_FetchProposalEventsFails() {
+	s.mockEventListener.EXPECT().FetchProposalEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+
+	_, err := s.proposalEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+
+	s.NotNil(err)
+}
+
+func (s *ProposalHandlerTestSuite) Test_ProposalEvents_TracksStatusChanges() {
+	s.mockEventListener.EXPECT().FetchProposalEvents(gomock.Any(), gomock.Any(), big.NewInt(0), big.NewInt(5)).Return([]*events.ProposalEvent{
+		{OriginDomainID: 1, DepositNonce: 3, Status: message.ProposalStatusPassed},
+		{OriginDomainID: 1, DepositNonce: 3, Status: message.ProposalStatusExecuted},
+	}, nil)
+	s.mockStatusTracker.EXPECT().TrackProposalStatus(uint8(1), s.domainID, uint64(3), message.ProposalStatusPassed)
+	s.mockStatusTracker.EXPECT().TrackProposalStatus(uint8(1), s.domainID, uint64(3), message.ProposalStatusExecuted)
+
+	msgChan := make(chan []*message.Message, 1)
+	handle, err := s.proposalEventHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
+
+	s.Nil(err)
+	s.Equal(len(msgChan), 0)
+}
+
+func (s *ProposalHandlerTestSuite) Test_FetchProposalVotesFails() {
+	s.mockEventListener.EXPECT().FetchProposalVotes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+
+	_, err := s.proposalVoteHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+
+	s.NotNil(err)
+}
+
+func (s *ProposalHandlerTestSuite) Test_ProposalVotes_TracksVotes() {
+	s.mockEventListener.EXPECT().FetchProposalVotes(gomock.Any(), gomock.Any(), big.NewInt(0), big.NewInt(5)).Return([]*events.ProposalVote{
+		{OriginDomainID: 1, DepositNonce: 3, Status: message.ProposalStatusActive},
+	}, nil)
+	s.mockVoteTracker.EXPECT().TrackProposalVote(uint8(1), s.domainID, uint64(3), message.ProposalStatusActive)
+
+	msgChan := make(chan []*message.Message, 1)
+	handle, err := s.proposalVoteHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), msgChan)
+
+	s.Nil(err)
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"math/big"
+	"strings"
+)
+
+// rangeLimitErrors are parts of errors returned by providers when log query
+// covers too many blocks or returns too many results
+var rangeLimitErrors = []string{
+	"query returned more than",
+	"block range",
+	"range too large",
+	"range is too large",
+	"limit exceeded",
+	"response size",
+	"too many results",
+	"query timeout exceeded",
+}
+
+// isRangeLimitError checks if provider rejected log query because of its size
+func isRangeLimitError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, e := range rangeLimitErrors {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// shrinkBlockInterval halves block interval after the provider rejected
+// the block range, down to min block interval.
+func (l *EVMListener) shrinkBlockInterval() {
+	interval := new(big.Int).Div(l.blockInterval, big.NewInt(2))
+	if interval.Cmp(l.minBlockInterval) == -1 {
+		interval = new(big.Int).Set(l.minBlockInterval)
+	}
+
+	if interval.Cmp(l.blockInterval) != 0 {
+		l.log.Info().Msgf("Decreasing block interval from %s to %s", l.blockInterval, interval)
+	}
+	l.blockInterval = interval
+}
+
+// growBlockInterval increases block interval by half, up to max block interval,
+// if there are at least two more intervals of confirmed blocks after endBlock.
+func (l *EVMListener) growBlockInterval(head *big.Int, endBlock *big.Int) {
+	confirmedBlocks := new(big.Int).Sub(new(big.Int).Sub(head, l.blockConfirmations), endBlock)
+	if confirmedBlocks.Cmp(new(big.Int).Mul(l.blockInterval, big.NewInt(2))) == -1 {
+		return
+	}
+
+	increase := new(big.Int).Div(l.blockInterval, big.NewInt(2))
+	if increase.Sign() == 0 {
+		increase = big.NewInt(1)
+	}
+	interval := new(big.Int).Add(l.blockInterval, increase)
+	if interval.Cmp(l.maxBlockInterval) == 1 {
+		interval = new(big.Int).Set(l.maxBlockInterval)
+	}
+
+	if interval.Cmp(l.blockInterval) != 0 {
+		l.log.Debug().Msgf("Increasing block interval from %s to %s", l.blockInterval, interval)
+	}
+	l.blockInterval = interval
+}
+
+// rangeEnd returns end of the next block range. Range grown while catching up
+// is cut to the latest confirmed block so it does not wait for the whole
+// interval to be confirmed, but never below min block interval.
+func (l *EVMListener) rangeEnd(startBlock *big.Int, head *big.Int) *big.Int {
+	endBlock := new(big.Int).Add(startBlock, l.blockInterval)
+	confirmedEnd := new(big.Int).Sub(head, l.blockConfirmations)
+	if endBlock.Cmp(confirmedEnd) == 1 && new(big.Int).Sub(confirmedEnd, startBlock).Cmp(l.minBlockInterval) != -1 {
+		return confirmedEnd
+	}
+	return endBlock
+}
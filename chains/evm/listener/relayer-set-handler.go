@@ -0,0 +1,153 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type RelayerSetEventListener interface {
+	FetchThresholdChanges(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) ([]*events.ThresholdChanged, error)
+	FetchRelayerChanges(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) ([]*events.RelayerChanged, error)
+}
+
+type RelayerSetContract interface {
+	GetThreshold() (uint8, error)
+	IsRelayer(relayerAddress common.Address) (bool, error)
+}
+
+type RelayerMembershipMeter interface {
+	TrackRelayerMembership(domainID uint8, isRelayer bool)
+}
+
+// RelayerSetHandler keeps a cached view of relayer threshold and relayer
+// membership on the bridge. Cached values are fetched from the bridge when
+// first needed and dropped when the listener sees they changed on chain.
+type RelayerSetHandler struct {
+	eventListener RelayerSetEventListener
+	contract      RelayerSetContract
+	metrics       RelayerMembershipMeter
+
+	bridgeAddress  common.Address
+	domainID       uint8
+	relayerAddress common.Address
+
+	lock      sync.Mutex
+	threshold *uint8
+	relayers  map[common.Address]bool
+}
+
+// NewRelayerSetHandler creates an instance of RelayerSetHandler that tracks
+// threshold and relayer changes on the bridge and reports if relayerAddress
+// is a relayer with metrics
+func NewRelayerSetHandler(
+	eventListener RelayerSetEventListener,
+	contract RelayerSetContract,
+	metrics RelayerMembershipMeter,
+	bridgeAddress common.Address,
+	domainID uint8,
+	relayerAddress common.Address,
+) *RelayerSetHandler {
+	return &RelayerSetHandler{
+		eventListener:  eventListener,
+		contract:       contract,
+		metrics:        metrics,
+		bridgeAddress:  bridgeAddress,
+		domainID:       domainID,
+		relayerAddress: relayerAddress,
+		relayers:       make(map[common.Address]bool),
+	}
+}
+
+// FetchEvents fetches threshold and relayer changes from the block range.
+// Returned function drops cached values that changed.
+func (h *RelayerSetHandler) FetchEvents(ctx context.Context, startBlock *big.Int, endBlock *big.Int) (HandleFunc, error) {
+	thresholdChanges, err := h.eventListener.FetchThresholdChanges(ctx, h.bridgeAddress, startBlock, endBlock)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch threshold changes because of: %w", err)
+	}
+	relayerChanges, err := h.eventListener.FetchRelayerChanges(ctx, h.bridgeAddress, startBlock, endBlock)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch relayer changes because of: %w", err)
+	}
+
+	return func(ctx context.Context, msgChan chan []*message.Message) error {
+		h.handleChanges(thresholdChanges, relayerChanges)
+		return nil
+	}, nil
+}
+
+func (h *RelayerSetHandler) handleChanges(thresholdChanges []*events.ThresholdChanged, relayerChanges []*events.RelayerChanged) {
+
+	h.lock.Lock()
+	if len(thresholdChanges) > 0 {
+		log.Info().Uint8("domainID", h.domainID).Msgf("Relayer threshold changed to %s", thresholdChanges[len(thresholdChanges)-1].NewThreshold)
+		h.threshold = nil
+	}
+	relayerChanged := false
+	for _, c := range relayerChanges {
+		delete(h.relayers, c.Relayer)
+		if c.Relayer == h.relayerAddress {
+			relayerChanged = true
+		}
+	}
+	h.lock.Unlock()
+
+	if relayerChanged {
+		// refresh membership right away so metrics report removal without waiting for a vote
+		_, err := h.IsRelayer(h.relayerAddress)
+		if err != nil {
+			log.Warn().Err(err).Uint8("domainID", h.domainID).Msgf("Unable to check if %s is a relayer", h.relayerAddress)
+		}
+	}
+}
+
+// GetThreshold returns number of votes required for a proposal to pass
+func (h *RelayerSetHandler) GetThreshold() (uint8, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.threshold != nil {
+		return *h.threshold, nil
+	}
+
+	threshold, err := h.contract.GetThreshold()
+	if err != nil {
+		return 0, err
+	}
+	h.threshold = &threshold
+	return threshold, nil
+}
+
+// IsRelayer checks if relayerAddress is allowed to vote on proposals
+func (h *RelayerSetHandler) IsRelayer(relayerAddress common.Address) (bool, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if isRelayer, ok := h.relayers[relayerAddress]; ok {
+		return isRelayer, nil
+	}
+
+	isRelayer, err := h.contract.IsRelayer(relayerAddress)
+	if err != nil {
+		return false, err
+	}
+	h.relayers[relayerAddress] = isRelayer
+
+	if relayerAddress == h.relayerAddress {
+		if !isRelayer {
+			log.Error().Uint8("domainID", h.domainID).Msgf("%s is not a relayer on bridge %s", relayerAddress, h.bridgeAddress)
+		}
+		h.metrics.TrackRelayerMembership(h.domainID, isRelayer)
+	}
+	return isRelayer, nil
+}
@@ -0,0 +1,123 @@
+package listener_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	mock_listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelayerSetHandlerTestSuite struct {
+	suite.Suite
+	relayerSetHandler *listener.RelayerSetHandler
+	mockEventListener *mock_listener.MockRelayerSetEventListener
+	mockContract      *mock_listener.MockRelayerSetContract
+	mockMetrics       *mock_listener.MockRelayerMembershipMeter
+	domainID          uint8
+	relayerAddress    common.Address
+}
+
+func TestRunRelayerSetHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerSetHandlerTestSuite))
+}
+
+func (s *RelayerSetHandlerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.domainID = 1
+	s.relayerAddress = common.HexToAddress("0x1")
+	s.mockEventListener = mock_listener.NewMockRelayerSetEventListener(ctrl)
+	s.mockContract = mock_listener.NewMockRelayerSetContract(ctrl)
+	s.mockMetrics = mock_listener.NewMockRelayerMembershipMeter(ctrl)
+	s.relayerSetHandler = listener.NewRelayerSetHandler(s.mockEventListener, s.mockContract, s.mockMetrics, common.Address{}, s.domainID, s.relayerAddress)
+}
+
+func (s *RelayerSetHandlerTestSuite) Test_GetThreshold_CachesThreshold() {
+	s.mockContract.EXPECT().GetThreshold().Return(uint8(2), nil)
+
+	threshold, err := s.relayerSetHandler.GetThreshold()
+	s.Nil(err)
+	s.Equal(uint8(2), threshold)
+
+	threshold, err = s.relayerSetHandler.GetThreshold()
+	s.Nil(err)
+	s.Equal(uint8(2), threshold)
+}
+
+func (s *RelayerSetHandlerTestSuite) Test_GetThreshold_FailedFetch() {
+	s.mockContract.EXPECT().GetThreshold().Return(uint8(0), fmt.Errorf("error"))
+
+	_, err := s.relayerSetHandler.GetThreshold()
+
+	s.NotNil(err)
+}
+
+func (s *RelayerSetHandlerTestSuite) Test_HandleEvent_FetchThresholdChangesFails() {
+	s.mockEventListener.EXPECT().FetchThresholdChanges(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+
+	_, err := s.relayerSetHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+
+	s.NotNil(err)
+}
+
+func (s *RelayerSetHandlerTestSuite) Test_HandleEvent_ThresholdChangeRefetchesThreshold() {
+	s.mockContract.EXPECT().GetThreshold().Return(uint8(2), nil)
+	_, _ = s.relayerSetHandler.GetThreshold()
+
+	s.mockEventListener.EXPECT().FetchThresholdChanges(gomock.Any(), gomock.Any(), big.NewInt(0), big.NewInt(5)).Return([]*events.ThresholdChanged{{NewThreshold: big.NewInt(3)}}, nil)
+	s.mockEventListener.EXPECT().FetchRelayerChanges(gomock.Any(), gomock.Any(), big.NewInt(0), big.NewInt(5)).Return([]*events.RelayerChanged{}, nil)
+	handle, err := s.relayerSetHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), make(chan []*message.Message))
+	s.Nil(err)
+
+	s.mockContract.EXPECT().GetThreshold().Return(uint8(3), nil)
+	threshold, err := s.relayerSetHandler.GetThreshold()
+	s.Nil(err)
+	s.Equal(uint8(3), threshold)
+}
+
+func (s *RelayerSetHandlerTestSuite) Test_IsRelayer_TracksMembershipOfRelayerKey() {
+	other := common.HexToAddress("0x2")
+	s.mockContract.EXPECT().IsRelayer(s.relayerAddress).Return(true, nil)
+	s.mockContract.EXPECT().IsRelayer(other).Return(false, nil)
+	s.mockMetrics.EXPECT().TrackRelayerMembership(s.domainID, true)
+
+	isRelayer, err := s.relayerSetHandler.IsRelayer(s.relayerAddress)
+	s.Nil(err)
+	s.True(isRelayer)
+	isRelayer, err = s.relayerSetHandler.IsRelayer(other)
+	s.Nil(err)
+	s.False(isRelayer)
+	// cached
+	isRelayer, err = s.relayerSetHandler.IsRelayer(s.relayerAddress)
+	s.Nil(err)
+	s.True(isRelayer)
+}
+
+func (s *RelayerSetHandlerTestSuite) Test_HandleEvent_ReportsRemovedRelayerKey() {
+	s.mockContract.EXPECT().IsRelayer(s.relayerAddress).Return(true, nil)
+	s.mockMetrics.EXPECT().TrackRelayerMembership(s.domainID, true)
+	_, _ = s.relayerSetHandler.IsRelayer(s.relayerAddress)
+
+	s.mockEventListener.EXPECT().FetchThresholdChanges(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*events.ThresholdChanged{}, nil)
+	s.mockEventListener.EXPECT().FetchRelayerChanges(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*events.RelayerChanged{{Relayer: s.relayerAddress}}, nil)
+	s.mockContract.EXPECT().IsRelayer(s.relayerAddress).Return(false, nil)
+	s.mockMetrics.EXPECT().TrackRelayerMembership(s.domainID, false)
+
+	handle, err := s.relayerSetHandler.FetchEvents(context.Background(), big.NewInt(0), big.NewInt(5))
+	s.Nil(err)
+	err = handle(context.Background(), make(chan []*message.Message))
+	s.Nil(err)
+
+	isRelayer, err := s.relayerSetHandler.IsRelayer(s.relayerAddress)
+	s.Nil(err)
+	s.False(isRelayer)
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// detectReorg compares hashes of processed blocks with the canonical chain and
+// returns the first block that has to be processed again if they differ.
+func (l *EVMListener) detectReorg(startBlock *big.Int, head *big.Int) (*big.Int, error) {
+	// hashes of blocks that are going to be processed again are not relevant
+	for len(l.blockHashes) > 0 && l.blockHashes[len(l.blockHashes)-1].Number.Cmp(startBlock) != -1 {
+		l.blockHashes = l.blockHashes[:len(l.blockHashes)-1]
+	}
+	if len(l.blockHashes) == 0 {
+		return nil, nil
+	}
+
+	latest := l.blockHashes[len(l.blockHashes)-1]
+	hash, err := l.client.BlockHash(latest.Number)
+	if err != nil {
+		return nil, err
+	}
+	if hash == latest.Hash {
+		return nil, nil
+	}
+
+	l.log.Warn().Msgf("Chain reorganization detected at block %s, expected hash %s got %s", latest.Number, latest.Hash, hash)
+	for i := len(l.blockHashes) - 2; i >= 0; i-- {
+		processed := l.blockHashes[i]
+		hash, err := l.client.BlockHash(processed.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		if hash == processed.Hash {
+			l.blockHashes = l.blockHashes[:i+1]
+			return new(big.Int).Add(processed.Number, big.NewInt(1)), nil
+		}
+	}
+
+	// reorganization is deeper than tracked blocks so process all of them
+	// again, but go back no further than max reorganization depth from head
+	forkBlock := new(big.Int).Set(l.blockHashes[0].Number)
+	minForkBlock := new(big.Int).Sub(head, l.maxReorgDepth)
+	if minForkBlock.Cmp(forkBlock) == 1 {
+		forkBlock = minForkBlock
+	}
+	if forkBlock.Sign() == -1 {
+		forkBlock = big.NewInt(0)
+	}
+	l.blockHashes = []store.BlockHash{}
+	return forkBlock, nil
+}
+
+// rewind retracts messages read from blocks no longer on the canonical chain
+// and moves the blockstore back to forkBlock so they are processed again.
+// Retraction handlers are notified so retracted messages aren't executed.
+func (l *EVMListener) rewind(forkBlock *big.Int) error {
+	retracted, err := l.retractor.RetractMessages(l.domainID, forkBlock)
+	if err != nil {
+		return err
+	}
+	for _, m := range retracted {
+		l.log.Warn().Uint8("destination", m.Destination).Uint64("nonce", m.DepositNonce).Msgf("Retracted message read from reorganized block")
+	}
+	if len(retracted) > 0 {
+		for _, handler := range l.retractionHandlers {
+			handler.Retract(retracted)
+		}
+	}
+
+	err = l.blockstore.StoreBlockHashes(l.domainID, l.blockHashes)
+	if err != nil {
+		return err
+	}
+
+	l.log.Info().Msgf("Rewinding to block %s", forkBlock)
+	return l.blockstore.StoreBlock(forkBlock, l.domainID)
+}
+
+// trackBlockHash adds hash of the processed block and drops hashes of blocks
+// older than max reorganization depth.
+func (l *EVMListener) trackBlockHash(block *big.Int, hash common.Hash) {
+	l.blockHashes = append(l.blockHashes, store.BlockHash{
+		Number: new(big.Int).Set(block),
+		Hash:   hash,
+	})
+
+	oldestBlock := new(big.Int).Sub(block, l.maxReorgDepth)
+	for len(l.blockHashes) > 1 && l.blockHashes[0].Number.Cmp(oldestBlock) == -1 {
+		l.blockHashes = l.blockHashes[1:]
+	}
+}
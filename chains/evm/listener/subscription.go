@@ -0,0 +1,131 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog"
+)
+
+const (
+	maxResubscribeInterval = time.Minute
+)
+
+// headSubscription keeps a new heads subscription alive. When the subscription
+// drops it is recreated with backoff and until then listener falls back to polling.
+type headSubscription struct {
+	subscriber HeadSubscriber
+	sub        ethereum.Subscription
+	heads      chan *types.Header
+
+	disabled          bool
+	retryAt           time.Time
+	minRetryInterval  time.Duration
+	nextRetryInterval time.Duration
+
+	log zerolog.Logger
+}
+
+func newHeadSubscription(subscriber HeadSubscriber, retryInterval time.Duration, log zerolog.Logger) *headSubscription {
+	return &headSubscription{
+		subscriber:        subscriber,
+		heads:             make(chan *types.Header, 1),
+		minRetryInterval:  retryInterval,
+		nextRetryInterval: retryInterval,
+		log:               log,
+	}
+}
+
+// active returns current subscription, subscribing if there is none and
+// resubscribe backoff expired. Returns nil if blocks should be polled instead.
+func (s *headSubscription) active(ctx context.Context) ethereum.Subscription {
+	if s.sub != nil || s.disabled || time.Now().Before(s.retryAt) {
+		return s.sub
+	}
+
+	sub, err := s.subscriber.SubscribeNewHead(ctx, s.heads)
+	if err != nil {
+		if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+			s.log.Info().Msg("Endpoint doesn't support subscriptions, polling for new blocks")
+			s.disabled = true
+			return nil
+		}
+
+		s.log.Warn().Err(err).Msgf("Failed subscribing to new heads, polling for new blocks for %s", s.nextRetryInterval)
+		s.backoff()
+		return nil
+	}
+
+	s.log.Debug().Msg("Subscribed to new heads")
+	s.sub = sub
+	s.nextRetryInterval = s.minRetryInterval
+	return s.sub
+}
+
+// drop discards failed subscription so it is recreated after backoff
+func (s *headSubscription) drop(err error) {
+	s.log.Warn().Err(err).Msgf("New heads subscription dropped, polling for new blocks for %s", s.nextRetryInterval)
+	s.sub.Unsubscribe()
+	s.sub = nil
+	s.backoff()
+}
+
+func (s *headSubscription) backoff() {
+	s.retryAt = time.Now().Add(s.nextRetryInterval)
+	s.nextRetryInterval *= 2
+	if s.nextRetryInterval > maxResubscribeInterval {
+		s.nextRetryInterval = maxResubscribeInterval
+	}
+}
+
+// drain discards heads received while blocks were being processed
+func (s *headSubscription) drain() {
+	for {
+		select {
+		case <-s.heads:
+		default:
+			return
+		}
+	}
+}
+
+func (s *headSubscription) close() {
+	if s.sub != nil {
+		s.sub.Unsubscribe()
+		s.sub = nil
+	}
+}
+
+// waitForBlock blocks until a new head is received or block retry interval passes
+func (l *EVMListener) waitForBlock(ctx context.Context) {
+	timer := time.NewTimer(l.blockRetryInterval)
+	defer timer.Stop()
+
+	var sub ethereum.Subscription
+	if l.headSubscription != nil {
+		sub = l.headSubscription.active(ctx)
+	}
+	if sub == nil {
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	case <-l.headSubscription.heads:
+		l.headSubscription.drain()
+	case err := <-sub.Err():
+		l.headSubscription.drop(err)
+	}
+}
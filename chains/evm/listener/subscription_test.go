@@ -0,0 +1,133 @@
+package listener_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	mock_listener "github.com/ChainSafe/chainbridge-core/chains/evm/listener/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type testSubscription struct {
+	errChan chan error
+}
+
+func (s *testSubscription) Unsubscribe() {}
+
+func (s *testSubscription) Err() <-chan error {
+	return s.errChan
+}
+
+type SubscriptionListenerTestSuite struct {
+	suite.Suite
+	listener            *listener.EVMListener
+	mockClient          *mock_listener.MockSubscriptionClient
+	mockEventHandler    *mock_listener.MockEventHandler
+	mockBlockStorer     *mock_listener.MockBlockStorer
+	mockRetractor       *mock_listener.MockMessageRetractor
+	mockBlockDeltaMeter *mock_listener.MockBlockDeltaMeter
+	domainID            uint8
+}
+
+func TestRunSubscriptionListenerTestSuite(t *testing.T) {
+	suite.Run(t, new(SubscriptionListenerTestSuite))
+}
+
+func (s *SubscriptionListenerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.domainID = 1
+	s.mockClient = mock_listener.NewMockSubscriptionClient(ctrl)
+	s.mockEventHandler = mock_listener.NewMockEventHandler(ctrl)
+	s.mockBlockStorer = mock_listener.NewMockBlockStorer(ctrl)
+	s.mockRetractor = mock_listener.NewMockMessageRetractor(ctrl)
+	s.mockBlockDeltaMeter = mock_listener.NewMockBlockDeltaMeter(ctrl)
+	s.listener = listener.NewEVMListenerWithSubscription(
+		s.mockClient,
+		[]listener.EventHandler{s.mockEventHandler},
+		s.mockBlockStorer,
+		s.mockRetractor,
+		s.mockBlockDeltaMeter,
+		s.domainID,
+		time.Second*10,
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(5),
+		big.NewInt(10),
+		1)
+}
+
+func (s *SubscriptionListenerTestSuite) Test_ListenToEvents_ProcessesBlocksOnNewHead() {
+	msgChan := make(chan []*message.Message, 2)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+	s.mockClient.EXPECT().SubscribeNewHead(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, ch chan<- *types.Header) (*testSubscription, error) {
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			ch <- &types.Header{Number: big.NewInt(110)}
+		}()
+		return &testSubscription{errChan: make(chan error)}, nil
+	})
+	// First pass waits for new head
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(109), nil)
+	// Second pass processes blocks without waiting for retry interval
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(110), nil)
+	s.mockClient.EXPECT().BlockHash(big.NewInt(104)).Return(common.Hash{1}, nil)
+	s.mockBlockDeltaMeter.EXPECT().TrackBlockDelta(s.domainID, big.NewInt(110), big.NewInt(105))
+	s.mockEventHandler.EXPECT().FetchEvents(gomock.Any(), big.NewInt(100), big.NewInt(104)).Return(handleNothing, nil)
+	s.mockBlockStorer.EXPECT().StoreBlockHashes(s.domainID, gomock.Any()).Return(nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(big.NewInt(105), s.domainID).Return(nil)
+	// Third pass waits for new head
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(110), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 100)
+	cancel()
+}
+
+func (s *SubscriptionListenerTestSuite) Test_ListenToEvents_FallsBackToPollingIfSubscriptionsUnsupported() {
+	msgChan := make(chan []*message.Message, 2)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+	s.mockClient.EXPECT().SubscribeNewHead(gomock.Any(), gomock.Any()).Return(nil, rpc.ErrNotificationsUnsupported)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(109), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
+
+func (s *SubscriptionListenerTestSuite) Test_ListenToEvents_DropsFailedSubscription() {
+	msgChan := make(chan []*message.Message, 2)
+	errChan := make(chan error, 1)
+	s.mockBlockStorer.EXPECT().GetBlockHashes(s.domainID).Return([]store.BlockHash{}, nil)
+	s.mockClient.EXPECT().SubscribeNewHead(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, ch chan<- *types.Header) (*testSubscription, error) {
+		errChan <- fmt.Errorf("error")
+		return &testSubscription{errChan: errChan}, nil
+	})
+	// First pass wait ends on subscription error
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(109), nil)
+	// Second pass polls without resubscribing
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(109), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go s.listener.ListenToEvents(ctx, big.NewInt(100), msgChan, nil)
+
+	time.Sleep(time.Millisecond * 50)
+	cancel()
+}
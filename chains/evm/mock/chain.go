@@ -0,0 +1,175 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/chain.go
+
+// Package mock_evm is a generated GoMock package.
+package mock_evm
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventListener is a mock of EventListener interface.
+type MockEventListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventListenerMockRecorder
+}
+
+// MockEventListenerMockRecorder is the mock recorder for MockEventListener.
+type MockEventListenerMockRecorder struct {
+	mock *MockEventListener
+}
+
+// NewMockEventListener creates a new mock instance.
+func NewMockEventListener(ctrl *gomock.Controller) *MockEventListener {
+	mock := &MockEventListener{ctrl: ctrl}
+	mock.recorder = &MockEventListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventListener) EXPECT() *MockEventListenerMockRecorder {
+	return m.recorder
+}
+
+// ListenToEvents mocks base method.
+func (m *MockEventListener) ListenToEvents(ctx context.Context, startBlock *big.Int, msgChan chan []*message.Message, errChan chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListenToEvents", ctx, startBlock, msgChan, errChan)
+}
+
+// ListenToEvents indicates an expected call of ListenToEvents.
+func (mr *MockEventListenerMockRecorder) ListenToEvents(ctx, startBlock, msgChan, errChan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenToEvents", reflect.TypeOf((*MockEventListener)(nil).ListenToEvents), ctx, startBlock, msgChan, errChan)
+}
+
+// MockProposalExecutor is a mock of ProposalExecutor interface.
+type MockProposalExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockProposalExecutorMockRecorder
+}
+
+// MockProposalExecutorMockRecorder is the mock recorder for MockProposalExecutor.
+type MockProposalExecutorMockRecorder struct {
+	mock *MockProposalExecutor
+}
+
+// NewMockProposalExecutor creates a new mock instance.
+func NewMockProposalExecutor(ctrl *gomock.Controller) *MockProposalExecutor {
+	mock := &MockProposalExecutor{ctrl: ctrl}
+	mock.recorder = &MockProposalExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProposalExecutor) EXPECT() *MockProposalExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockProposalExecutor) Execute(message *message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockProposalExecutorMockRecorder) Execute(message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockProposalExecutor)(nil).Execute), message)
+}
+
+// MockMessageOutbox is a mock of MessageOutbox interface.
+type MockMessageOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageOutboxMockRecorder
+}
+
+// MockMessageOutboxMockRecorder is the mock recorder for MockMessageOutbox.
+type MockMessageOutboxMockRecorder struct {
+	mock *MockMessageOutbox
+}
+
+// NewMockMessageOutbox creates a new mock instance.
+func NewMockMessageOutbox(ctrl *gomock.Controller) *MockMessageOutbox {
+	mock := &MockMessageOutbox{ctrl: ctrl}
+	mock.recorder = &MockMessageOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageOutbox) EXPECT() *MockMessageOutboxMockRecorder {
+	return m.recorder
+}
+
+// MarkDone mocks base method.
+func (m_2 *MockMessageOutbox) MarkDone(m *message.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "MarkDone", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDone indicates an expected call of MarkDone.
+func (mr *MockMessageOutboxMockRecorder) MarkDone(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDone", reflect.TypeOf((*MockMessageOutbox)(nil).MarkDone), m)
+}
+
+// PendingMessages mocks base method.
+func (m *MockMessageOutbox) PendingMessages(source uint8) ([]*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingMessages", source)
+	ret0, _ := ret[0].([]*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingMessages indicates an expected call of PendingMessages.
+func (mr *MockMessageOutboxMockRecorder) PendingMessages(source interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingMessages", reflect.TypeOf((*MockMessageOutbox)(nil).PendingMessages), source)
+}
+
+// MockDeadLetterStorer is a mock of DeadLetterStorer interface.
+type MockDeadLetterStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterStorerMockRecorder
+}
+
+// MockDeadLetterStorerMockRecorder is the mock recorder for MockDeadLetterStorer.
+type MockDeadLetterStorerMockRecorder struct {
+	mock *MockDeadLetterStorer
+}
+
+// NewMockDeadLetterStorer creates a new mock instance.
+func NewMockDeadLetterStorer(ctrl *gomock.Controller) *MockDeadLetterStorer {
+	mock := &MockDeadLetterStorer{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterStorer) EXPECT() *MockDeadLetterStorerMockRecorder {
+	return m.recorder
+}
+
+// StoreDeadLetter mocks base method.
+func (m_2 *MockDeadLetterStorer) StoreDeadLetter(m *message.Message, err error, attempts int) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "StoreDeadLetter", m, err, attempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreDeadLetter indicates an expected call of StoreDeadLetter.
+func (mr *MockDeadLetterStorerMockRecorder) StoreDeadLetter(m, err, attempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreDeadLetter", reflect.TypeOf((*MockDeadLetterStorer)(nil).StoreDeadLetter), m, err, attempts)
+}
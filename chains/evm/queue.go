@@ -0,0 +1,199 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package evm
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+)
+
+var ErrQueueStopped = errors.New("execution queue stopped")
+
+type execution struct {
+	msg     *message.Message
+	results chan<- *message.ExecutionResult
+}
+
+// executionQueue executes messages with a bounded number of workers.
+// If ordered, messages from the same source are executed one at a time
+// in deposit nonce order. Queue can be run again after it was stopped.
+type executionQueue struct {
+	execute     func(ctx context.Context, m *message.Message) error
+	concurrency int
+	ordered     bool
+
+	lock    sync.Mutex
+	cond    *sync.Cond
+	pending []*execution
+	running map[uint8]bool
+	// queued are IDs of messages that are pending or executing
+	queued  map[string]bool
+	stopped bool
+	// generation is increased on every run so workers of a stopped
+	// run don't pick up messages of the next one
+	generation uint64
+}
+
+func newExecutionQueue(execute func(ctx context.Context, m *message.Message) error, concurrency int, ordered bool) *executionQueue {
+	q := &executionQueue{
+		execute:     execute,
+		concurrency: concurrency,
+		ordered:     ordered,
+		pending:     make([]*execution, 0),
+		running:     make(map[uint8]bool),
+		queued:      make(map[string]bool),
+	}
+	q.cond = sync.NewCond(&q.lock)
+	return q
+}
+
+// push adds messages to the queue. Messages that are already pending or
+// executing are not added again and fail with message.ErrMessageAlreadyQueued.
+func (q *executionQueue) push(msgs []*message.Message, results chan<- *message.ExecutionResult) error {
+	q.lock.Lock()
+	if q.stopped {
+		q.lock.Unlock()
+		return ErrQueueStopped
+	}
+
+	duplicates := make([]*message.Message, 0)
+	for _, m := range msgs {
+		if q.queued[m.ID()] {
+			duplicates = append(duplicates, m)
+			continue
+		}
+		q.queued[m.ID()] = true
+		q.pending = append(q.pending, &execution{msg: m, results: results})
+	}
+	if q.ordered {
+		sort.SliceStable(q.pending, func(i, j int) bool {
+			return q.pending[i].msg.DepositNonce < q.pending[j].msg.DepositNonce
+		})
+	}
+
+	q.cond.Broadcast()
+	q.lock.Unlock()
+
+	for _, m := range duplicates {
+		results <- &message.ExecutionResult{Message: m, Err: message.ErrMessageAlreadyQueued}
+	}
+	return nil
+}
+
+// remove drops pending executions of msgs from the queue and fails them
+// with message.ErrMessageRetracted. Messages already executing are not affected.
+func (q *executionQueue) remove(msgs []*message.Message) {
+	retracted := make(map[string]bool, len(msgs))
+	for _, m := range msgs {
+		retracted[m.ID()] = true
+	}
+
+	q.lock.Lock()
+	removed := make([]*execution, 0)
+	pending := q.pending[:0]
+	for _, e := range q.pending {
+		if retracted[e.msg.ID()] {
+			removed = append(removed, e)
+			delete(q.queued, e.msg.ID())
+			continue
+		}
+		pending = append(pending, e)
+	}
+	q.pending = pending
+	q.lock.Unlock()
+
+	for _, e := range removed {
+		e.results <- &message.ExecutionResult{Message: e.msg, Err: message.ErrMessageRetracted}
+	}
+}
+
+// run starts queue workers and fails all pending messages when ctx is done
+func (q *executionQueue) run(ctx context.Context) {
+	q.lock.Lock()
+	q.generation++
+	generation := q.generation
+	q.stopped = false
+	q.cond.Broadcast()
+	q.lock.Unlock()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < q.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx, generation)
+		}()
+	}
+
+	<-ctx.Done()
+	q.lock.Lock()
+	if q.generation != generation {
+		// queue was already restarted
+		q.lock.Unlock()
+		wg.Wait()
+		return
+	}
+	q.stopped = true
+	pending := q.pending
+	q.pending = nil
+	for _, e := range pending {
+		delete(q.queued, e.msg.ID())
+	}
+	q.cond.Broadcast()
+	q.lock.Unlock()
+
+	for _, e := range pending {
+		e.results <- &message.ExecutionResult{Message: e.msg, Err: ErrQueueStopped}
+	}
+	wg.Wait()
+}
+
+func (q *executionQueue) work(ctx context.Context, generation uint64) {
+	for {
+		e := q.next(generation)
+		if e == nil {
+			return
+		}
+
+		err := q.execute(ctx, e.msg)
+		e.results <- &message.ExecutionResult{Message: e.msg, Err: err}
+
+		q.lock.Lock()
+		delete(q.running, e.msg.Source)
+		delete(q.queued, e.msg.ID())
+		q.cond.Broadcast()
+		q.lock.Unlock()
+	}
+}
+
+// next blocks until there is a message that can be executed
+// and returns nil if the queue run with generation is stopped
+func (q *executionQueue) next(generation uint64) *execution {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for {
+		if q.stopped || q.generation != generation {
+			return nil
+		}
+
+		for i, e := range q.pending {
+			if q.ordered && q.running[e.msg.Source] {
+				continue
+			}
+
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			if q.ordered {
+				q.running[e.msg.Source] = true
+			}
+			return e
+		}
+
+		q.cond.Wait()
+	}
+}
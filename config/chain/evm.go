@@ -17,29 +17,63 @@ type EVMConfig struct {
 	Erc721Handler          string
 	GenericHandler         string
 	MaxGasPrice            *big.Int
+	MaxGasTipCap           *big.Int
 	GasMultiplier          *big.Float
 	GasPriceIncreaseFactor *big.Int
 	GasLimit               *big.Int
 	StartBlock             *big.Int
 	BlockConfirmations     *big.Int
 	BlockInterval          *big.Int
+	MinBlockInterval       *big.Int
+	MaxBlockInterval       *big.Int
 	BlockRetryInterval     time.Duration
+	MaxReorgDepth          *big.Int
+	BackfillWorkers        int
+	ExecutionConcurrency   int
+	OrderedExecution       bool
+	MaxRetries             int
+	RetryInterval          time.Duration
+	MaxRetryInterval       time.Duration
+	MinBalance             *big.Int
+	BlockSubscription      bool
+	FinalityTag            string
+	TxTimeout              time.Duration
+	TxTimeoutAction        string
+	Keys                   []string
+	KeySelection           string
 }
 
 type RawEVMConfig struct {
 	GeneralChainConfig     `mapstructure:",squash"`
-	Bridge                 string  `mapstructure:"bridge"`
-	Erc20Handler           string  `mapstructure:"erc20Handler"`
-	Erc721Handler          string  `mapstructure:"erc721Handler"`
-	GenericHandler         string  `mapstructure:"genericHandler"`
-	MaxGasPrice            int64   `mapstructure:"maxGasPrice" default:"20000000000"`
-	GasPriceIncreaseFactor int64   `mapstructure:"gasPriceIncreaseFactor" default:"15"`
-	GasMultiplier          float64 `mapstructure:"gasMultiplier" default:"1"`
-	GasLimit               int64   `mapstructure:"gasLimit" default:"2000000"`
-	StartBlock             int64   `mapstructure:"startBlock"`
-	BlockConfirmations     int64   `mapstructure:"blockConfirmations" default:"10"`
-	BlockInterval          int64   `mapstructure:"blockInterval" default:"5"`
-	BlockRetryInterval     uint64  `mapstructure:"blockRetryInterval" default:"5"`
+	Bridge                 string   `mapstructure:"bridge"`
+	Erc20Handler           string   `mapstructure:"erc20Handler"`
+	Erc721Handler          string   `mapstructure:"erc721Handler"`
+	GenericHandler         string   `mapstructure:"genericHandler"`
+	MaxGasPrice            int64    `mapstructure:"maxGasPrice" default:"20000000000"`
+	MaxGasTipCap           int64    `mapstructure:"maxGasTipCap"`
+	GasPriceIncreaseFactor int64    `mapstructure:"gasPriceIncreaseFactor" default:"15"`
+	GasMultiplier          float64  `mapstructure:"gasMultiplier" default:"1"`
+	GasLimit               int64    `mapstructure:"gasLimit" default:"2000000"`
+	StartBlock             int64    `mapstructure:"startBlock"`
+	BlockConfirmations     int64    `mapstructure:"blockConfirmations" default:"10"`
+	BlockInterval          int64    `mapstructure:"blockInterval" default:"5"`
+	MinBlockInterval       int64    `mapstructure:"minBlockInterval"`
+	MaxBlockInterval       int64    `mapstructure:"maxBlockInterval"`
+	BlockRetryInterval     uint64   `mapstructure:"blockRetryInterval" default:"5"`
+	MaxReorgDepth          int64    `mapstructure:"maxReorgDepth" default:"128"`
+	BackfillWorkers        int      `mapstructure:"backfillWorkers" default:"1"`
+	ExecutionConcurrency   int      `mapstructure:"executionConcurrency" default:"10"`
+	OrderedExecution       bool     `mapstructure:"orderedExecution"`
+	MaxRetries             int      `mapstructure:"maxRetries" default:"5"`
+	RetryInterval          uint64   `mapstructure:"retryInterval" default:"5"`
+	MaxRetryInterval       uint64   `mapstructure:"maxRetryInterval" default:"300"`
+	MinBalance             int64    `mapstructure:"minBalance"`
+	BlockSubscription      bool     `mapstructure:"blockSubscription"`
+	FinalityTag            string   `mapstructure:"finalityTag"`
+	TxTimeout              uint64   `mapstructure:"txTimeout" default:"600"`
+	TxTimeoutAction        string   `mapstructure:"txTimeoutAction" default:"cancel"`
+	Keys                   []string `mapstructure:"keys"`
+	KeySelection           string   `mapstructure:"keySelection" default:"round-robin"`
 }
 
 func (c *RawEVMConfig) Validate() error {
@@ -52,6 +86,36 @@ func (c *RawEVMConfig) Validate() error {
 	if c.BlockConfirmations != 0 && c.BlockConfirmations < 1 {
 		return fmt.Errorf("blockConfirmations has to be >=1")
 	}
+	if c.MinBlockInterval < 0 || c.MinBlockInterval > c.BlockInterval {
+		return fmt.Errorf("minBlockInterval has to be >=0 and <=blockInterval")
+	}
+	if c.MaxBlockInterval != 0 && c.MaxBlockInterval < c.BlockInterval {
+		return fmt.Errorf("maxBlockInterval has to be >=blockInterval")
+	}
+	if c.FinalityTag != "" && c.FinalityTag != "finalized" && c.FinalityTag != "safe" {
+		return fmt.Errorf("finalityTag has to be one of: finalized, safe")
+	}
+	if c.TxTimeoutAction != "cancel" && c.TxTimeoutAction != "drop" {
+		return fmt.Errorf("txTimeoutAction has to be one of: cancel, drop")
+	}
+	if c.KeySelection != "round-robin" && c.KeySelection != "least-pending" {
+		return fmt.Errorf("keySelection has to be one of: round-robin, least-pending")
+	}
+	if c.MaxReorgDepth < 0 {
+		return fmt.Errorf("maxReorgDepth has to be >=0")
+	}
+	if c.BackfillWorkers < 1 {
+		return fmt.Errorf("backfillWorkers has to be >=1")
+	}
+	if c.ExecutionConcurrency < 1 {
+		return fmt.Errorf("executionConcurrency has to be >=1")
+	}
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("maxRetries has to be >=0")
+	}
+	if c.MinBalance < 0 {
+		return fmt.Errorf("minBalance has to be >=0")
+	}
 	return nil
 }
 
@@ -75,6 +139,12 @@ func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
 	}
 
 	c.GeneralChainConfig.ParseFlags()
+	if c.MinBlockInterval == 0 {
+		c.MinBlockInterval = c.BlockInterval
+	}
+	if c.MaxBlockInterval == 0 {
+		c.MaxBlockInterval = c.BlockInterval
+	}
 	config := &EVMConfig{
 		GeneralChainConfig:     c.GeneralChainConfig,
 		Erc20Handler:           c.Erc20Handler,
@@ -84,11 +154,28 @@ func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
 		BlockRetryInterval:     time.Duration(c.BlockRetryInterval) * time.Second,
 		GasLimit:               big.NewInt(c.GasLimit),
 		MaxGasPrice:            big.NewInt(c.MaxGasPrice),
+		MaxGasTipCap:           big.NewInt(c.MaxGasTipCap),
 		GasPriceIncreaseFactor: big.NewInt(c.GasPriceIncreaseFactor),
 		GasMultiplier:          big.NewFloat(c.GasMultiplier),
 		StartBlock:             big.NewInt(c.StartBlock),
 		BlockConfirmations:     big.NewInt(c.BlockConfirmations),
 		BlockInterval:          big.NewInt(c.BlockInterval),
+		MinBlockInterval:       big.NewInt(c.MinBlockInterval),
+		MaxBlockInterval:       big.NewInt(c.MaxBlockInterval),
+		MaxReorgDepth:          big.NewInt(c.MaxReorgDepth),
+		BackfillWorkers:        c.BackfillWorkers,
+		ExecutionConcurrency:   c.ExecutionConcurrency,
+		OrderedExecution:       c.OrderedExecution,
+		MaxRetries:             c.MaxRetries,
+		RetryInterval:          time.Duration(c.RetryInterval) * time.Second,
+		MaxRetryInterval:       time.Duration(c.MaxRetryInterval) * time.Second,
+		MinBalance:             big.NewInt(c.MinBalance),
+		BlockSubscription:      c.BlockSubscription,
+		FinalityTag:            c.FinalityTag,
+		TxTimeout:              time.Duration(c.TxTimeout) * time.Second,
+		TxTimeoutAction:        c.TxTimeoutAction,
+		Keys:                   c.Keys,
+		KeySelection:           c.KeySelection,
 	}
 
 	return config, nil
@@ -56,6 +56,77 @@ func (s *NewEVMConfigTestSuite) Test_InvalidBlockConfirmation() {
 	s.Equal(err.Error(), "blockConfirmations has to be >=1")
 }
 
+func (s *NewEVMConfigTestSuite) Test_InvalidFinalityTag() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":          1,
+		"endpoint":    "ws://domain.com",
+		"name":        "evm1",
+		"from":        "address",
+		"bridge":      "bridgeAddress",
+		"finalityTag": "latest",
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "finalityTag has to be one of: finalized, safe")
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidTxTimeoutAction() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":              1,
+		"endpoint":        "ws://domain.com",
+		"name":            "evm1",
+		"from":            "address",
+		"bridge":          "bridgeAddress",
+		"txTimeoutAction": "resend",
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "txTimeoutAction has to be one of: cancel, drop")
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidKeySelection() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":           1,
+		"endpoint":     "ws://domain.com",
+		"name":         "evm1",
+		"from":         "address",
+		"bridge":       "bridgeAddress",
+		"keySelection": "random",
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "keySelection has to be one of: round-robin, least-pending")
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidMaxRetries() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":         1,
+		"endpoint":   "ws://domain.com",
+		"name":       "evm1",
+		"from":       "address",
+		"bridge":     "bridgeAddress",
+		"maxRetries": -1,
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "maxRetries has to be >=0")
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidMaxBlockInterval() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":               1,
+		"endpoint":         "ws://domain.com",
+		"name":             "evm1",
+		"from":             "address",
+		"bridge":           "bridgeAddress",
+		"blockInterval":    10,
+		"maxBlockInterval": 5,
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "maxBlockInterval has to be >=blockInterval")
+}
+
 func (s *NewEVMConfigTestSuite) Test_ValidConfig() {
 	rawConfig := map[string]interface{}{
 		"id":             1,
@@ -88,12 +159,25 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfig() {
 		GenericHandler:         "",
 		GasLimit:               big.NewInt(2000000),
 		MaxGasPrice:            big.NewInt(20000000000),
+		MaxGasTipCap:           big.NewInt(0),
 		GasMultiplier:          big.NewFloat(1),
 		GasPriceIncreaseFactor: big.NewInt(15),
 		StartBlock:             big.NewInt(0),
 		BlockConfirmations:     big.NewInt(10),
 		BlockInterval:          big.NewInt(5),
+		MinBlockInterval:       big.NewInt(5),
+		MaxBlockInterval:       big.NewInt(5),
 		BlockRetryInterval:     time.Duration(5) * time.Second,
+		MaxReorgDepth:          big.NewInt(128),
+		BackfillWorkers:        1,
+		ExecutionConcurrency:   10,
+		MaxRetries:             5,
+		RetryInterval:          time.Duration(5) * time.Second,
+		MaxRetryInterval:       time.Duration(300) * time.Second,
+		MinBalance:             big.NewInt(0),
+		TxTimeout:              time.Duration(600) * time.Second,
+		TxTimeoutAction:        "cancel",
+		KeySelection:           "round-robin",
 	})
 }
 
@@ -105,6 +189,7 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
 		"from":                   "address",
 		"bridge":                 "bridgeAddress",
 		"maxGasPrice":            1000,
+		"maxGasTipCap":           100,
 		"gasPriceIncreaseFactor": 20,
 		"gasMultiplier":          1000,
 		"gasLimit":               1000,
@@ -112,6 +197,22 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
 		"blockConfirmations":     10,
 		"blockRetryInterval":     10,
 		"blockInterval":          2,
+		"minBlockInterval":       1,
+		"maxBlockInterval":       100,
+		"maxReorgDepth":          64,
+		"backfillWorkers":        4,
+		"executionConcurrency":   2,
+		"orderedExecution":       true,
+		"maxRetries":             3,
+		"retryInterval":          1,
+		"maxRetryInterval":       60,
+		"minBalance":             1000000,
+		"blockSubscription":      true,
+		"finalityTag":            "finalized",
+		"txTimeout":              60,
+		"txTimeoutAction":        "drop",
+		"keys":                   []string{"key1", "key2"},
+		"keySelection":           "least-pending",
 	}
 
 	actualConfig, err := chain.NewEVMConfig(rawConfig)
@@ -131,11 +232,28 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
 		GenericHandler:         "",
 		GasLimit:               big.NewInt(1000),
 		MaxGasPrice:            big.NewInt(1000),
+		MaxGasTipCap:           big.NewInt(100),
 		GasPriceIncreaseFactor: big.NewInt(20),
 		GasMultiplier:          big.NewFloat(1000),
 		StartBlock:             big.NewInt(1000),
 		BlockConfirmations:     big.NewInt(10),
 		BlockInterval:          big.NewInt(2),
+		MinBlockInterval:       big.NewInt(1),
+		MaxBlockInterval:       big.NewInt(100),
 		BlockRetryInterval:     time.Duration(10) * time.Second,
+		MaxReorgDepth:          big.NewInt(64),
+		BackfillWorkers:        4,
+		ExecutionConcurrency:   2,
+		OrderedExecution:       true,
+		MaxRetries:             3,
+		RetryInterval:          time.Duration(1) * time.Second,
+		MaxRetryInterval:       time.Duration(60) * time.Second,
+		MinBalance:             big.NewInt(1000000),
+		BlockSubscription:      true,
+		FinalityTag:            "finalized",
+		TxTimeout:              time.Duration(60) * time.Second,
+		TxTimeoutAction:        "drop",
+		Keys:                   []string{"key1", "key2"},
+		KeySelection:           "least-pending",
 	})
 }
@@ -2,11 +2,14 @@ package config_test
 
 import (
 	"encoding/json"
+	"math/big"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ChainSafe/chainbridge-core/config"
 	"github.com/ChainSafe/chainbridge-core/config/relayer"
+	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -80,6 +83,11 @@ func (s *GetConfigTestSuite) Test_ValidConfig() {
 			LogLevel:                  1,
 			LogFile:                   "out.log",
 			OpenTelemetryCollectorURL: "",
+			HealthStallIntervals:      10,
+			MaxChainRestarts:          5,
+			ChainRestartInterval:      time.Second * 5,
+			MaxChainRestartInterval:   time.Second * 300,
+			ShutdownTimeout:           time.Second * 30,
 		},
 		ChainConfigs: []map[string]interface{}{{
 			"type": "evm",
@@ -87,3 +95,54 @@ func (s *GetConfigTestSuite) Test_ValidConfig() {
 		}},
 	})
 }
+
+func (s *GetConfigTestSuite) Test_ProcessorsConfig() {
+	data := config.RawConfig{
+		RelayerConfig: relayer.RawRelayerConfig{
+			LogLevel: "info",
+			Processors: relayer.RawProcessorsConfig{
+				ResourceDenylist: []string{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+				DisabledRoutes:   []relayer.Route{{Source: 1, Destination: 2}},
+				MaxAmounts:       map[string]string{"0x0000000000000000000000000000000000000000000000000000000000000001": "100"},
+			},
+		},
+		ChainConfigs: []map[string]interface{}{{
+			"type": "evm",
+			"name": "evm1",
+		}},
+	}
+	file, _ := json.Marshal(data)
+	_ = os.WriteFile("test.json", file, 0644)
+
+	actualConfig, err := config.GetConfig("test.json")
+
+	_ = os.Remove("test.json")
+	s.Nil(err)
+	s.Equal(actualConfig.RelayerConfig.Processors, relayer.ProcessorsConfig{
+		ResourceDenylist: []types.ResourceID{{31: 1}},
+		DisabledRoutes:   []relayer.Route{{Source: 1, Destination: 2}},
+		MaxAmounts:       map[types.ResourceID]*big.Int{{31: 1}: big.NewInt(100)},
+	})
+}
+
+func (s *GetConfigTestSuite) Test_InvalidProcessorsResourceID() {
+	data := config.RawConfig{
+		RelayerConfig: relayer.RawRelayerConfig{
+			LogLevel: "info",
+			Processors: relayer.RawProcessorsConfig{
+				ResourceAllowlist: []string{"0x01"},
+			},
+		},
+		ChainConfigs: []map[string]interface{}{{
+			"type": "evm",
+			"name": "evm1",
+		}},
+	}
+	file, _ := json.Marshal(data)
+	_ = os.WriteFile("test.json", file, 0644)
+
+	_, err := config.GetConfig("test.json")
+
+	_ = os.Remove("test.json")
+	s.NotNil(err)
+}
@@ -1,8 +1,13 @@
 package relayer
 
 import (
+	"encoding/hex"
 	"fmt"
+	"math/big"
+	"strings"
+	"time"
 
+	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/rs/zerolog"
 )
 
@@ -12,17 +17,77 @@ type RelayerConfig struct {
 	LogFile                   string
 	Env                       string
 	Id                        string
+	AdminAPIAddress           string
+	AdminAPIToken             string
+	ExplorerAPIAddress        string
+	HealthAPIAddress          string
+	HealthStallIntervals      int
+	MaxChainRestarts          int
+	ChainRestartInterval      time.Duration
+	MaxChainRestartInterval   time.Duration
+	ShutdownTimeout           time.Duration
+	Processors                ProcessorsConfig
+}
+
+// ProcessorsConfig configures built-in message processors
+type ProcessorsConfig struct {
+	ResourceAllowlist     []types.ResourceID
+	ResourceDenylist      []types.ResourceID
+	DisabledRoutes        []Route
+	MaxAmounts            map[types.ResourceID]*big.Int
+	RecipientDenylistFile string
+	MetadataFields        []string
+	Rules                 []Rule
+	Lists                 map[string][]string
+}
+
+// Rule is a named expression, messages that match it are not relayed
+type Rule struct {
+	Name       string `mapstructure:"Name" json:"name"`
+	Expression string `mapstructure:"Expression" json:"expression"`
+}
+
+type Route struct {
+	Source      uint8 `mapstructure:"Source" json:"source"`
+	Destination uint8 `mapstructure:"Destination" json:"destination"`
 }
 
 type RawRelayerConfig struct {
-	OpenTelemetryCollectorURL string `mapstructure:"OpenTelemetryCollectorURL" json:"opentelemetryCollectorURL"`
-	LogLevel                  string `mapstructure:"LogLevel" json:"logLevel" default:"info"`
-	LogFile                   string `mapstructure:"LogFile" json:"logFile" default:"out.log"`
-	Env                       string `mapstructure:"Env" json:"env"`
-	Id                        string `mapstructure:"Id" json:"id"`
+	OpenTelemetryCollectorURL string              `mapstructure:"OpenTelemetryCollectorURL" json:"opentelemetryCollectorURL"`
+	LogLevel                  string              `mapstructure:"LogLevel" json:"logLevel" default:"info"`
+	LogFile                   string              `mapstructure:"LogFile" json:"logFile" default:"out.log"`
+	Env                       string              `mapstructure:"Env" json:"env"`
+	Id                        string              `mapstructure:"Id" json:"id"`
+	AdminAPIAddress           string              `mapstructure:"AdminAPIAddress" json:"adminAPIAddress"`
+	AdminAPIToken             string              `mapstructure:"AdminAPIToken" json:"adminAPIToken"`
+	ExplorerAPIAddress        string              `mapstructure:"ExplorerAPIAddress" json:"explorerAPIAddress"`
+	HealthAPIAddress          string              `mapstructure:"HealthAPIAddress" json:"healthAPIAddress"`
+	HealthStallIntervals      int                 `mapstructure:"HealthStallIntervals" json:"healthStallIntervals" default:"10"`
+	MaxChainRestarts          int                 `mapstructure:"MaxChainRestarts" json:"maxChainRestarts" default:"5"`
+	ChainRestartInterval      uint64              `mapstructure:"ChainRestartInterval" json:"chainRestartInterval" default:"5"`
+	MaxChainRestartInterval   uint64              `mapstructure:"MaxChainRestartInterval" json:"maxChainRestartInterval" default:"300"`
+	ShutdownTimeout           uint64              `mapstructure:"ShutdownTimeout" json:"shutdownTimeout" default:"30"`
+	Processors                RawProcessorsConfig `mapstructure:"Processors" json:"processors"`
+}
+
+type RawProcessorsConfig struct {
+	ResourceAllowlist     []string            `mapstructure:"ResourceAllowlist" json:"resourceAllowlist"`
+	ResourceDenylist      []string            `mapstructure:"ResourceDenylist" json:"resourceDenylist"`
+	DisabledRoutes        []Route             `mapstructure:"DisabledRoutes" json:"disabledRoutes"`
+	MaxAmounts            map[string]string   `mapstructure:"MaxAmounts" json:"maxAmounts"`
+	RecipientDenylistFile string              `mapstructure:"RecipientDenylistFile" json:"recipientDenylistFile"`
+	MetadataFields        []string            `mapstructure:"MetadataFields" json:"metadataFields"`
+	Rules                 []Rule              `mapstructure:"Rules" json:"rules"`
+	Lists                 map[string][]string `mapstructure:"Lists" json:"lists"`
 }
 
 func (c *RawRelayerConfig) Validate() error {
+	if c.HealthStallIntervals < 1 {
+		return fmt.Errorf("healthStallIntervals has to be >=1")
+	}
+	if c.MaxChainRestarts < 0 {
+		return fmt.Errorf("maxChainRestarts has to be >=0")
+	}
 	return nil
 }
 
@@ -44,6 +109,84 @@ func NewRelayerConfig(rawConfig RawRelayerConfig) (RelayerConfig, error) {
 	config.OpenTelemetryCollectorURL = rawConfig.OpenTelemetryCollectorURL
 	config.Env = rawConfig.Env
 	config.Id = rawConfig.Id
+	config.AdminAPIAddress = rawConfig.AdminAPIAddress
+	config.AdminAPIToken = rawConfig.AdminAPIToken
+	config.ExplorerAPIAddress = rawConfig.ExplorerAPIAddress
+	config.HealthAPIAddress = rawConfig.HealthAPIAddress
+	config.HealthStallIntervals = rawConfig.HealthStallIntervals
+	config.MaxChainRestarts = rawConfig.MaxChainRestarts
+	config.ChainRestartInterval = time.Duration(rawConfig.ChainRestartInterval) * time.Second
+	config.MaxChainRestartInterval = time.Duration(rawConfig.MaxChainRestartInterval) * time.Second
+	config.ShutdownTimeout = time.Duration(rawConfig.ShutdownTimeout) * time.Second
+
+	processors, err := newProcessorsConfig(rawConfig.Processors)
+	if err != nil {
+		return config, err
+	}
+	config.Processors = processors
+
+	return config, nil
+}
+
+func newProcessorsConfig(rawConfig RawProcessorsConfig) (ProcessorsConfig, error) {
+	config := ProcessorsConfig{
+		DisabledRoutes:        rawConfig.DisabledRoutes,
+		RecipientDenylistFile: rawConfig.RecipientDenylistFile,
+		MetadataFields:        rawConfig.MetadataFields,
+		Rules:                 rawConfig.Rules,
+		Lists:                 rawConfig.Lists,
+	}
+
+	var err error
+	config.ResourceAllowlist, err = parseResourceIDs(rawConfig.ResourceAllowlist)
+	if err != nil {
+		return config, err
+	}
+	config.ResourceDenylist, err = parseResourceIDs(rawConfig.ResourceDenylist)
+	if err != nil {
+		return config, err
+	}
+
+	if rawConfig.MaxAmounts != nil {
+		config.MaxAmounts = make(map[types.ResourceID]*big.Int, len(rawConfig.MaxAmounts))
+		for rawID, rawAmount := range rawConfig.MaxAmounts {
+			id, err := parseResourceID(rawID)
+			if err != nil {
+				return config, err
+			}
+			amount, ok := new(big.Int).SetString(rawAmount, 10)
+			if !ok || amount.Sign() == -1 {
+				return config, fmt.Errorf("invalid max amount %s for resource %s", rawAmount, rawID)
+			}
+			config.MaxAmounts[id] = amount
+		}
+	}
 
 	return config, nil
 }
+
+func parseResourceIDs(rawIDs []string) ([]types.ResourceID, error) {
+	if rawIDs == nil {
+		return nil, nil
+	}
+
+	ids := make([]types.ResourceID, len(rawIDs))
+	for i, rawID := range rawIDs {
+		id, err := parseResourceID(rawID)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func parseResourceID(rawID string) (types.ResourceID, error) {
+	var id types.ResourceID
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(rawID), "0x"))
+	if err != nil || len(b) != len(id) {
+		return id, fmt.Errorf("invalid resource ID %s", rawID)
+	}
+	copy(id[:], b)
+	return id, nil
+}
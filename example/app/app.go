@@ -5,40 +5,31 @@ package app
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"os/signal"
+	"reflect"
 	"syscall"
-	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 
-	secp256k1 "github.com/ethereum/go-ethereum/crypto"
-
-	"github.com/ChainSafe/chainbridge-core/chains/evm"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/api"
 	"github.com/ChainSafe/chainbridge-core/config"
 	"github.com/ChainSafe/chainbridge-core/config/chain"
-	secp256k12 "github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
-	"github.com/ChainSafe/chainbridge-core/e2e/dummy"
 	"github.com/ChainSafe/chainbridge-core/flags"
+	"github.com/ChainSafe/chainbridge-core/health"
 	"github.com/ChainSafe/chainbridge-core/lvldb"
 	"github.com/ChainSafe/chainbridge-core/opentelemetry"
 	"github.com/ChainSafe/chainbridge-core/relayer"
+	"github.com/ChainSafe/chainbridge-core/relayer/processors"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
 	"github.com/ChainSafe/chainbridge-core/store"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 )
 
 func Run() error {
-	configuration, err := config.GetConfig(viper.GetString(flags.ConfigFlagName))
+	configPath := viper.GetString(flags.ConfigFlagName)
+	configuration, err := config.GetConfig(configPath)
 	if err != nil {
 		panic(err)
 	}
@@ -48,17 +39,15 @@ func Run() error {
 		panic(err)
 	}
 	blockstore := store.NewBlockStore(db)
+	outbox := store.NewOutbox(db)
+	deadLetters := store.NewDeadLetterStore(db)
+	deposits := store.NewDepositStore(db)
+	txJournal := store.NewTxJournal(db)
 
 	mp, err := opentelemetry.InitMetricProvider(context.Background(), configuration.RelayerConfig.OpenTelemetryCollectorURL)
 	if err != nil {
 		panic(err)
 	}
-	defer func() {
-		if err := mp.Shutdown(context.Background()); err != nil {
-			log.Error().Msgf("Error shutting down meter provider: %v", err)
-		}
-	}()
-
 	metrics, err := opentelemetry.NewRelayerMetrics(mp.Meter("relayer-metric-provider"), attribute.String("relayerid", configuration.RelayerConfig.Id), attribute.String("env", configuration.RelayerConfig.Env))
 	if err != nil {
 		panic(err)
@@ -66,84 +55,181 @@ func Run() error {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	chains := []relayer.RelayedChain{}
+	domains := make(map[uint8]*evmDomain)
+	healthChecker := health.NewChecker(blockstore, configuration.RelayerConfig.HealthStallIntervals)
 	for _, chainConfig := range configuration.ChainConfigs {
-		switch chainConfig["type"] {
-		case "evm":
-			{
-				config, err := chain.NewEVMConfig(chainConfig)
-				if err != nil {
-					panic(err)
-				}
-
-				privateKey, err := secp256k1.HexToECDSA(config.GeneralChainConfig.Key)
-				if err != nil {
-					panic(err)
-				}
-
-				kp := secp256k12.NewKeypair(*privateKey)
-
-				client, err := evmclient.NewEVMClient(config.GeneralChainConfig.Endpoint, kp)
-				if err != nil {
-					panic(err)
-				}
-
-				dummyGasPricer := dummy.NewStaticGasPriceDeterminant(client, nil)
-				t := monitored.NewMonitoredTransactor(evmtransaction.NewTransaction, dummyGasPricer, client, config.MaxGasPrice, config.GasPriceIncreaseFactor)
-				go t.Monitor(ctx, time.Minute*3, time.Minute*10, time.Minute)
-				bridgeContract := bridge.NewBridgeContract(client, common.HexToAddress(config.Bridge), t)
-
-				depositHandler := listener.NewETHDepositHandler(bridgeContract)
-				depositHandler.RegisterDepositHandler(config.Erc20Handler, listener.Erc20DepositHandler)
-				depositHandler.RegisterDepositHandler(config.Erc721Handler, listener.Erc721DepositHandler)
-				depositHandler.RegisterDepositHandler(config.GenericHandler, listener.GenericDepositHandler)
-				eventListener := events.NewListener(client)
-				eventHandlers := make([]listener.EventHandler, 0)
-				eventHandlers = append(eventHandlers, listener.NewDepositEventHandler(eventListener, depositHandler, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id))
-				evmListener := listener.NewEVMListener(client, eventHandlers, blockstore, metrics, *config.GeneralChainConfig.Id, config.BlockRetryInterval, config.BlockConfirmations, config.BlockInterval)
-
-				mh := executor.NewEVMMessageHandler(bridgeContract)
-				mh.RegisterMessageHandler(config.Erc20Handler, executor.ERC20MessageHandler)
-				mh.RegisterMessageHandler(config.Erc721Handler, executor.ERC721MessageHandler)
-				mh.RegisterMessageHandler(config.GenericHandler, executor.GenericMessageHandler)
-
-				var evmVoter *executor.EVMVoter
-				evmVoter, err = executor.NewVoterWithSubscription(mh, client, bridgeContract)
-				if err != nil {
-					log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
-					evmVoter = executor.NewVoter(mh, client, bridgeContract)
-				}
-
-				chain := evm.NewEVMChain(evmListener, evmVoter, blockstore, *config.GeneralChainConfig.Id, config.StartBlock, config.GeneralChainConfig.LatestBlock, config.GeneralChainConfig.FreshStart)
-
-				chains = append(chains, chain)
-			}
-		default:
-			panic(fmt.Errorf("type '%s' not recognized", chainConfig["type"]))
+		d, err := newDomain(chainConfig, blockstore, outbox, deadLetters, deposits, txJournal, metrics)
+		if err != nil {
+			panic(err)
 		}
+
+		chains = append(chains, d.chain)
+		domains[d.id] = d
+		d.registerHealth(healthChecker)
 	}
 
+	messageProcessors, err := processors.NewProcessors(configuration.RelayerConfig.Processors)
+	if err != nil {
+		panic(err)
+	}
 	r := relayer.NewRelayer(
 		chains,
 		metrics,
+		retry.NewPolicy(configuration.RelayerConfig.MaxChainRestarts, configuration.RelayerConfig.ChainRestartInterval, configuration.RelayerConfig.MaxChainRestartInterval),
+		messageProcessors...,
 	)
+	r.RegisterOutbox(outbox)
+	r.RegisterErrorReporter(healthChecker)
+	for _, d := range domains {
+		d.voter.RegisterSubmitter(r)
+		d.listener.RegisterRetractionHandler(r)
+	}
 
 	errChn := make(chan error)
-	defer cancel()
 	go r.Start(ctx, errChn)
+	go healthChecker.Start(ctx, health.DefaultCheckInterval)
+
+	var server *api.Server
+	if configuration.RelayerConfig.AdminAPIAddress != "" {
+		server = api.NewServer(configuration.RelayerConfig.AdminAPIAddress, blockstore, outbox, deadLetters, r)
+		for domainID, d := range domains {
+			server.RegisterDomain(domainID, d.transactorPool)
+			server.RegisterKeys(domainID, d.transactorPool)
+			server.RegisterDepositFinder(domainID, d.depositFinder)
+		}
+		server.RegisterAuthToken(configuration.RelayerConfig.AdminAPIToken)
+		go server.Start(ctx, errChn)
+	}
+	if configuration.RelayerConfig.HealthAPIAddress != "" {
+		healthServer := api.NewHealthServer(configuration.RelayerConfig.HealthAPIAddress, healthChecker)
+		go healthServer.Start(ctx, errChn)
+	}
+	if configuration.RelayerConfig.ExplorerAPIAddress != "" {
+		explorer := api.NewExplorer(configuration.RelayerConfig.ExplorerAPIAddress, deposits)
+		go explorer.Start(ctx, errChn)
+	}
+
+	addDomain := func(chainConfig map[string]interface{}) {
+		d, err := newDomain(chainConfig, blockstore, outbox, deadLetters, deposits, txJournal, metrics)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed creating chain from config %v", chainConfig)
+			return
+		}
+		d.voter.RegisterSubmitter(r)
+		d.listener.RegisterRetractionHandler(r)
+		err = r.AddChain(d.chain)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed adding chain %v", d.id)
+			d.stopMonitor(ctx)
+			return
+		}
+
+		domains[d.id] = d
+		d.registerHealth(healthChecker)
+		if server != nil {
+			server.RegisterDomain(d.id, d.transactorPool)
+			server.RegisterKeys(d.id, d.transactorPool)
+			server.RegisterDepositFinder(d.id, d.depositFinder)
+		}
+	}
+	removeDomain := func(d *evmDomain) {
+		if server != nil {
+			server.RemoveDomain(d.id)
+		}
+		healthChecker.RemoveChain(d.id)
+		delete(domains, d.id)
+
+		removeCtx, cancelRemove := context.WithTimeout(context.Background(), configuration.RelayerConfig.ShutdownTimeout)
+		defer cancelRemove()
+		err := r.RemoveChain(removeCtx, d.id)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed removing chain %v", d.id)
+		}
+		d.stopMonitor(removeCtx)
+	}
+	// reload restarts chains whose config changed and adds or removes chains
+	// that were added to or removed from the config file
+	reload := func() {
+		reloaded, err := config.GetConfig(configPath)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed reloading config")
+			return
+		}
+
+		chainConfigs := make(map[uint8]map[string]interface{})
+		for _, chainConfig := range reloaded.ChainConfigs {
+			c, err := chain.NewEVMConfig(chainConfig)
+			if err != nil {
+				log.Error().Err(err).Msg("Invalid chain config, reload aborted")
+				return
+			}
+			chainConfigs[*c.GeneralChainConfig.Id] = chainConfig
+		}
+
+		for domainID, d := range domains {
+			if chainConfig, ok := chainConfigs[domainID]; ok && reflect.DeepEqual(chainConfig, d.rawConfig) {
+				continue
+			}
+			removeDomain(d)
+		}
+		for domainID, chainConfig := range chainConfigs {
+			if _, ok := domains[domainID]; ok {
+				continue
+			}
+			addDomain(chainConfig)
+		}
+	}
 
 	sysErr := make(chan os.Signal, 1)
 	signal.Notify(sysErr,
 		syscall.SIGTERM,
 		syscall.SIGINT,
-		syscall.SIGHUP,
 		syscall.SIGQUIT)
+	reloadSig := make(chan os.Signal, 1)
+	signal.Notify(reloadSig, syscall.SIGHUP)
+
+	var runErr error
+loop:
+	for {
+		select {
+		case err := <-errChn:
+			healthChecker.ReportError(err)
+			log.Error().Err(err).Msg("failed to listen and serve")
+			runErr = err
+			break loop
+		case sig := <-sysErr:
+			log.Info().Msgf("terminating got ` [%v] signal", sig)
+			break loop
+		case <-reloadSig:
+			log.Info().Msg("Reloading chains config")
+			reload()
+		}
+	}
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), configuration.RelayerConfig.ShutdownTimeout)
+	defer cancelShutdown()
+
+	// stop listeners and wait for executions in progress
+	cancel()
+	if err := r.Stop(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("Relayer didn't stop before shutdown timeout")
+	}
+
+	// wait for sent transactions to be included
+	for _, d := range domains {
+		d.stopMonitor(shutdownCtx)
+	}
+
+	if err := mp.ForceFlush(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("Failed flushing metrics")
+	}
+	if err := mp.Shutdown(shutdownCtx); err != nil {
+		log.Error().Msgf("Error shutting down meter provider: %v", err)
+	}
 
-	select {
-	case err := <-errChn:
-		log.Error().Err(err).Msg("failed to listen and serve")
-		return err
-	case sig := <-sysErr:
-		log.Info().Msgf("terminating got ` [%v] signal", sig)
-		return nil
+	if err := db.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed closing database")
 	}
+	log.Info().Msg("Relayer stopped")
+	return runErr
 }
@@ -0,0 +1,219 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	secp256k1 "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/monitored"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/pool"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	secp256k12 "github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ChainSafe/chainbridge-core/e2e/dummy"
+	"github.com/ChainSafe/chainbridge-core/health"
+	"github.com/ChainSafe/chainbridge-core/opentelemetry"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// evmDomain is an EVM chain with the components that are started and
+// stopped together with it
+type evmDomain struct {
+	id                 uint8
+	rawConfig          map[string]interface{}
+	config             *chain.EVMConfig
+	chain              *evm.EVMChain
+	client             *evmclient.EVMClient
+	listenerClient     listener.SubscriptionClient
+	blockConfirmations *big.Int
+	transactorPool     *pool.TransactorPool
+	transactors        []*monitored.MonitoredTransactor
+	keyClients         []*evmclient.EVMClient
+	listener           *listener.EVMListener
+	depositFinder      *listener.DepositFinder
+	voter              *executor.EVMVoter
+	cancelMonitor      context.CancelFunc
+}
+
+// newDomain creates a chain from its raw config and starts monitoring its transactions
+func newDomain(
+	rawConfig map[string]interface{},
+	blockstore *store.BlockStore,
+	outbox *store.Outbox,
+	deadLetters *store.DeadLetterStore,
+	deposits *store.DepositStore,
+	txJournal *store.TxJournal,
+	metrics *opentelemetry.RelayerMetrics,
+) (*evmDomain, error) {
+	if rawConfig["type"] != "evm" {
+		return nil, fmt.Errorf("type '%s' not recognized", rawConfig["type"])
+	}
+
+	config, err := chain.NewEVMConfig(rawConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	keyClients := make([]*evmclient.EVMClient, 0, len(config.Keys)+1)
+	transactors := make([]*monitored.MonitoredTransactor, 0, len(config.Keys)+1)
+	for _, key := range append([]string{config.GeneralChainConfig.Key}, config.Keys...) {
+		keyClient, t, err := newKeyTransactor(config, key, txJournal)
+		if err != nil {
+			for _, c := range keyClients {
+				c.Close()
+			}
+			return nil, err
+		}
+		keyClients = append(keyClients, keyClient)
+		transactors = append(transactors, t)
+	}
+	client := keyClients[0]
+
+	// transactors are stopped separately from the chain so they keep monitoring
+	// sent transactions while the chain is shutting down
+	monitorCtx, cancelMonitor := context.WithCancel(context.Background())
+	transactorPool := pool.NewTransactorPool(client, pool.Selection(config.KeySelection))
+	for i, t := range transactors {
+		go keyClients[i].MonitorNonce(monitorCtx, evmtransaction.NewTransaction, t, time.Minute, time.Minute*10)
+		go t.Monitor(monitorCtx, time.Minute*3, config.TxTimeout, time.Minute)
+		transactorPool.RegisterKey(keyClients[i].From(), t)
+	}
+	bridgeContract := bridge.NewBridgeContract(client, common.HexToAddress(config.Bridge), transactorPool)
+
+	depositHandler := listener.NewETHDepositHandler(bridgeContract)
+	depositHandler.RegisterDepositHandler(config.Erc20Handler, listener.Erc20DepositHandler)
+	depositHandler.RegisterDepositHandler(config.Erc721Handler, listener.Erc721DepositHandler)
+	depositHandler.RegisterDepositHandler(config.GenericHandler, listener.GenericDepositHandler)
+	eventListener := events.NewListener(client)
+	eventHandlers := make([]listener.EventHandler, 0)
+	depositEventHandler := listener.NewDepositEventHandler(eventListener, depositHandler, outbox, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id)
+	depositEventHandler.RegisterTracker(deposits)
+	eventHandlers = append(eventHandlers, depositEventHandler)
+	proposalEventHandler := listener.NewProposalEventHandler(eventListener, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id)
+	proposalEventHandler.RegisterTracker(metrics)
+	proposalEventHandler.RegisterTracker(deposits)
+	proposalEventHandler.RegisterTracker(outbox)
+	eventHandlers = append(eventHandlers, proposalEventHandler)
+	proposalVoteHandler := listener.NewProposalVoteHandler(eventListener, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id)
+	proposalVoteHandler.RegisterTracker(metrics)
+	eventHandlers = append(eventHandlers, proposalVoteHandler)
+	relayerSetHandler := listener.NewRelayerSetHandler(eventListener, bridgeContract, metrics, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id, client.RelayerAddress())
+	eventHandlers = append(eventHandlers, relayerSetHandler)
+	var listenerClient listener.SubscriptionClient = client
+	blockConfirmations := config.BlockConfirmations
+	if config.FinalityTag != "" {
+		listenerClient = listener.NewFinalityClient(client, config.FinalityTag, config.BlockConfirmations)
+		blockConfirmations = big.NewInt(0)
+	}
+	depositFinder := listener.NewDepositFinder(listenerClient, eventListener, depositHandler, bridgeContract, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id, blockConfirmations, config.MaxBlockInterval)
+	var evmListener *listener.EVMListener
+	if config.BlockSubscription {
+		evmListener = listener.NewEVMListenerWithSubscription(listenerClient, eventHandlers, blockstore, outbox, metrics, *config.GeneralChainConfig.Id, config.BlockRetryInterval, blockConfirmations, config.BlockInterval, config.MinBlockInterval, config.MaxBlockInterval, config.MaxReorgDepth, config.BackfillWorkers)
+	} else {
+		evmListener = listener.NewEVMListener(listenerClient, eventHandlers, blockstore, outbox, metrics, *config.GeneralChainConfig.Id, config.BlockRetryInterval, blockConfirmations, config.BlockInterval, config.MinBlockInterval, config.MaxBlockInterval, config.MaxReorgDepth, config.BackfillWorkers)
+	}
+	evmListener.RegisterRetractionHandler(deposits)
+
+	mh := executor.NewEVMMessageHandler(bridgeContract)
+	mh.RegisterMessageHandler(config.Erc20Handler, executor.ERC20MessageHandler)
+	mh.RegisterMessageHandler(config.Erc721Handler, executor.ERC721MessageHandler)
+	mh.RegisterMessageHandler(config.GenericHandler, executor.GenericMessageHandler)
+
+	var evmVoter *executor.EVMVoter
+	evmVoter, err = executor.NewVoterWithSubscription(mh, client, bridgeContract, relayerSetHandler)
+	if err != nil {
+		log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
+		evmVoter = executor.NewVoter(mh, client, bridgeContract, relayerSetHandler)
+	}
+	transactorPool.RegisterRelayerSet(relayerSetHandler)
+	evmVoter.RegisterTracker(deposits)
+	evmVoter.RegisterStatusTracker(outbox)
+	for i, t := range transactors {
+		t.RegisterTimeoutHandler(evmVoter)
+		if i > 0 {
+			evmVoter.RegisterRelayerAddress(keyClients[i].RelayerAddress())
+		}
+	}
+
+	retryPolicy := retry.NewPolicy(config.MaxRetries, config.RetryInterval, config.MaxRetryInterval)
+	evmChain := evm.NewEVMChain(evmListener, evmVoter, blockstore, outbox, deadLetters, retryPolicy, *config.GeneralChainConfig.Id, config.StartBlock, config.GeneralChainConfig.LatestBlock, config.GeneralChainConfig.FreshStart, config.ExecutionConcurrency, config.OrderedExecution)
+
+	return &evmDomain{
+		id:                 *config.GeneralChainConfig.Id,
+		rawConfig:          rawConfig,
+		config:             config,
+		chain:              evmChain,
+		client:             client,
+		listenerClient:     listenerClient,
+		blockConfirmations: blockConfirmations,
+		transactorPool:     transactorPool,
+		transactors:        transactors,
+		keyClients:         keyClients,
+		listener:           evmListener,
+		depositFinder:      depositFinder,
+		voter:              evmVoter,
+		cancelMonitor:      cancelMonitor,
+	}, nil
+}
+
+// newKeyTransactor creates a client with its own nonce tracking and
+// a monitored transactor that sends transactions signed with key
+func newKeyTransactor(config *chain.EVMConfig, key string, txJournal *store.TxJournal) (*evmclient.EVMClient, *monitored.MonitoredTransactor, error) {
+	privateKey, err := secp256k1.HexToECDSA(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kp := secp256k12.NewKeypair(*privateKey)
+
+	client, err := evmclient.NewEVMClient(config.GeneralChainConfig.Endpoint, kp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dummyGasPricer := dummy.NewStaticGasPriceDeterminant(client, nil)
+	t := monitored.NewMonitoredTransactor(evmtransaction.NewTransaction, dummyGasPricer, client, config.MaxGasPrice, config.MaxGasTipCap, config.GasPriceIncreaseFactor, monitored.TimeoutAction(config.TxTimeoutAction))
+	err = t.RegisterJournal(*config.GeneralChainConfig.Id, txJournal)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return client, t, nil
+}
+
+func (d *evmDomain) registerHealth(checker *health.Checker) {
+	relayerAddresses := make([]common.Address, len(d.keyClients))
+	for i, c := range d.keyClients {
+		relayerAddresses[i] = c.RelayerAddress()
+	}
+	checker.RegisterChain(d.id, d.chain, d.listenerClient, d.client, relayerAddresses, d.config.MinBalance, d.blockConfirmations, d.config.BlockRetryInterval)
+}
+
+// stopMonitor waits for sent transactions to be included until ctx is done
+// and stops monitoring them
+func (d *evmDomain) stopMonitor(ctx context.Context) {
+	for _, t := range d.transactors {
+		for _, tx := range t.WaitPending(ctx, time.Second) {
+			log.Warn().Uint8("domainID", d.id).Uint64("nonce", tx.Nonce).Msgf("Transaction %s of %s still pending on shutdown", tx.Hash, tx.From)
+		}
+	}
+	d.cancelMonitor()
+	for _, c := range d.keyClients {
+		c.Close()
+	}
+}
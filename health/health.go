@@ -0,0 +1,278 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultCheckInterval is how often chains are checked
+const DefaultCheckInterval = time.Second * 5
+
+var ErrNotChecked = errors.New("health not checked yet")
+
+type PollingChain interface {
+	Polling() bool
+}
+
+type BlockClient interface {
+	LatestBlock() (*big.Int, error)
+}
+
+type BalanceClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+type BlockStorer interface {
+	GetLastStoredBlock(domainID uint8) (*big.Int, error)
+}
+
+type chainHealth struct {
+	domainID           uint8
+	chain              PollingChain
+	blockClient        BlockClient
+	balanceClient      BalanceClient
+	relayerAddresses   []common.Address
+	minBalance         *big.Int
+	blockConfirmations *big.Int
+	stallTimeout       time.Duration
+
+	lastBlock    *big.Int
+	lastProgress time.Time
+	// chainErr is the last error the chain failed with, cleared once
+	// the listener stores a block after chainErrBlock
+	chainErr      error
+	chainErrBlock *big.Int
+}
+
+// Checker periodically checks registered chains and reports whether
+// the relayer is ready to relay messages and whether it is healthy.
+type Checker struct {
+	blockstore     BlockStorer
+	stallIntervals int
+
+	lock    sync.RWMutex
+	chains  []*chainHealth
+	ready   error
+	healthy error
+	sysErr  error
+}
+
+// NewChecker creates a Checker that considers a listener stalled if it has not
+// stored a new block for stallIntervals block retry intervals while there
+// were confirmed blocks to process.
+func NewChecker(blockstore BlockStorer, stallIntervals int) *Checker {
+	return &Checker{
+		blockstore:     blockstore,
+		stallIntervals: stallIntervals,
+		ready:          ErrNotChecked,
+		healthy:        ErrNotChecked,
+	}
+}
+
+// RegisterChain adds chain to health checks. Chain is unhealthy if
+// balance of any of the relayer keys is at or below minBalance.
+func (c *Checker) RegisterChain(
+	domainID uint8,
+	chain PollingChain,
+	blockClient BlockClient,
+	balanceClient BalanceClient,
+	relayerAddresses []common.Address,
+	minBalance *big.Int,
+	blockConfirmations *big.Int,
+	blockRetryInterval time.Duration,
+) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.chains = append(c.chains, &chainHealth{
+		domainID:           domainID,
+		chain:              chain,
+		blockClient:        blockClient,
+		balanceClient:      balanceClient,
+		relayerAddresses:   relayerAddresses,
+		minBalance:         minBalance,
+		blockConfirmations: blockConfirmations,
+		stallTimeout:       blockRetryInterval * time.Duration(c.stallIntervals),
+	})
+}
+
+// RemoveChain removes chain from health checks
+func (c *Checker) RemoveChain(domainID uint8) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	chains := make([]*chainHealth, 0, len(c.chains))
+	for _, ch := range c.chains {
+		if ch.domainID != domainID {
+			chains = append(chains, ch)
+		}
+	}
+	c.chains = chains
+}
+
+// ReportChainError marks relayer unhealthy right away after chain failed.
+// Chain is healthy again once its listener stores a new block.
+func (c *Checker) ReportChainError(domainID uint8, err error) {
+	block, blockErr := c.blockstore.GetLastStoredBlock(domainID)
+	if blockErr != nil {
+		log.Warn().Err(blockErr).Msgf("Unable to get last stored block of domain %d", domainID)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, ch := range c.chains {
+		if ch.domainID == domainID {
+			ch.chainErr = err
+			ch.chainErrBlock = block
+			c.healthy = fmt.Errorf("domain %d: chain failed: %w", domainID, err)
+		}
+	}
+}
+
+// ReportError marks relayer unhealthy after an error was sent to the system error channel
+func (c *Checker) ReportError(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sysErr = err
+}
+
+// Ready returns nil if every chain is polling and its RPC endpoint answers
+func (c *Checker) Ready() error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.ready
+}
+
+// Healthy returns nil if no listener is stalled, relayer has funds on every chain
+// and no system error was reported
+func (c *Checker) Healthy() error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.sysErr != nil {
+		return fmt.Errorf("system error: %w", c.sysErr)
+	}
+	return c.healthy
+}
+
+// Start checks registered chains every checkInterval until ctx is done
+func (c *Checker) Start(ctx context.Context, checkInterval time.Duration) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check checks all registered chains and updates readiness and health
+func (c *Checker) Check(ctx context.Context) {
+	c.lock.RLock()
+	chains := c.chains
+	c.lock.RUnlock()
+
+	var ready error
+	var healthy error
+	for _, ch := range chains {
+		r, h := c.checkChain(ctx, ch)
+		if ready == nil && r != nil {
+			ready = fmt.Errorf("domain %d: %w", ch.domainID, r)
+		}
+		if healthy == nil && h != nil {
+			healthy = fmt.Errorf("domain %d: %w", ch.domainID, h)
+		}
+	}
+	if healthy != nil {
+		log.Warn().Err(healthy).Msg("Relayer is unhealthy")
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.ready = ready
+	c.healthy = healthy
+}
+
+func (c *Checker) checkChain(ctx context.Context, ch *chainHealth) (ready error, healthy error) {
+	if !ch.chain.Polling() {
+		return errors.New("chain is not polling"), nil
+	}
+
+	head, err := ch.blockClient.LatestBlock()
+	if err != nil {
+		return fmt.Errorf("unable to get latest block: %w", err), nil
+	}
+
+	for _, address := range ch.relayerAddresses {
+		balance, err := ch.balanceClient.BalanceAt(ctx, address, nil)
+		if err != nil {
+			return fmt.Errorf("unable to get balance of relayer %s: %w", address, err), nil
+		}
+		if balance.Cmp(ch.minBalance) != 1 {
+			return nil, fmt.Errorf("relayer %s is out of funds with balance %s", address, balance)
+		}
+	}
+
+	return nil, c.checkProgress(ch, head)
+}
+
+// checkProgress detects a listener that does not store new blocks even though
+// there are confirmed blocks to process
+func (c *Checker) checkProgress(ch *chainHealth, head *big.Int) error {
+	block, err := c.blockstore.GetLastStoredBlock(ch.domainID)
+	if err != nil {
+		return fmt.Errorf("unable to get last stored block: %w", err)
+	}
+
+	if err := c.chainError(ch, block); err != nil {
+		return err
+	}
+
+	if ch.lastBlock == nil || block.Cmp(ch.lastBlock) != 0 {
+		ch.lastBlock = block
+		ch.lastProgress = time.Now()
+		return nil
+	}
+
+	confirmedHead := new(big.Int).Sub(head, ch.blockConfirmations)
+	if confirmedHead.Cmp(block) == 1 && time.Since(ch.lastProgress) > ch.stallTimeout {
+		return fmt.Errorf("listener has not advanced from block %s since %s", block, ch.lastProgress.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// chainError returns the last error chain failed with, unless the listener
+// stored a new block since then
+func (c *Checker) chainError(ch *chainHealth, block *big.Int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if ch.chainErr == nil {
+		return nil
+	}
+	if ch.chainErrBlock != nil && block.Cmp(ch.chainErrBlock) == 1 {
+		ch.chainErr = nil
+		ch.chainErrBlock = nil
+		return nil
+	}
+	return fmt.Errorf("chain failed: %w", ch.chainErr)
+}
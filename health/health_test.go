@@ -0,0 +1,158 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/health"
+	mock_health "github.com/ChainSafe/chainbridge-core/health/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CheckerTestSuite struct {
+	suite.Suite
+	checker           *health.Checker
+	mockChain         *mock_health.MockPollingChain
+	mockBlockClient   *mock_health.MockBlockClient
+	mockBalanceClient *mock_health.MockBalanceClient
+	mockBlockStorer   *mock_health.MockBlockStorer
+}
+
+func TestRunCheckerTestSuite(t *testing.T) {
+	suite.Run(t, new(CheckerTestSuite))
+}
+
+func (s *CheckerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockChain = mock_health.NewMockPollingChain(gomockController)
+	s.mockBlockClient = mock_health.NewMockBlockClient(gomockController)
+	s.mockBalanceClient = mock_health.NewMockBalanceClient(gomockController)
+	s.mockBlockStorer = mock_health.NewMockBlockStorer(gomockController)
+	s.checker = health.NewChecker(s.mockBlockStorer, 2)
+	s.checker.RegisterChain(1, s.mockChain, s.mockBlockClient, s.mockBalanceClient, []common.Address{{}}, big.NewInt(10), big.NewInt(5), time.Millisecond*5)
+}
+
+func (s *CheckerTestSuite) TestNotReadyBeforeFirstCheck() {
+	s.Equal(health.ErrNotChecked, s.checker.Ready())
+	s.Equal(health.ErrNotChecked, s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestNotReadyIfChainIsNotPolling() {
+	s.mockChain.EXPECT().Polling().Return(false)
+
+	s.checker.Check(context.Background())
+
+	s.NotNil(s.checker.Ready())
+	s.Nil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestNotReadyIfRPCFails() {
+	s.mockChain.EXPECT().Polling().Return(true)
+	s.mockBlockClient.EXPECT().LatestBlock().Return(nil, errors.New("error"))
+
+	s.checker.Check(context.Background())
+
+	s.NotNil(s.checker.Ready())
+	s.Nil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestUnhealthyIfOutOfFunds() {
+	s.mockChain.EXPECT().Polling().Return(true)
+	s.mockBlockClient.EXPECT().LatestBlock().Return(big.NewInt(100), nil)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{}, nil).Return(big.NewInt(10), nil)
+
+	s.checker.Check(context.Background())
+
+	s.Nil(s.checker.Ready())
+	s.NotNil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestUnhealthyIfAdditionalKeyOutOfFunds() {
+	checker := health.NewChecker(s.mockBlockStorer, 2)
+	checker.RegisterChain(1, s.mockChain, s.mockBlockClient, s.mockBalanceClient, []common.Address{{}, common.HexToAddress("0x2")}, big.NewInt(10), big.NewInt(5), time.Millisecond*5)
+	s.mockChain.EXPECT().Polling().Return(true)
+	s.mockBlockClient.EXPECT().LatestBlock().Return(big.NewInt(100), nil)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{}, nil).Return(big.NewInt(11), nil)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x2"), nil).Return(big.NewInt(10), nil)
+
+	checker.Check(context.Background())
+
+	s.Nil(checker.Ready())
+	s.NotNil(checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestReadyAndHealthy() {
+	s.mockChain.EXPECT().Polling().Return(true)
+	s.mockBlockClient.EXPECT().LatestBlock().Return(big.NewInt(100), nil)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{}, nil).Return(big.NewInt(11), nil)
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(1)).Return(big.NewInt(90), nil)
+
+	s.checker.Check(context.Background())
+
+	s.Nil(s.checker.Ready())
+	s.Nil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestUnhealthyIfListenerStalled() {
+	s.mockChain.EXPECT().Polling().Return(true).Times(2)
+	s.mockBlockClient.EXPECT().LatestBlock().Return(big.NewInt(100), nil).Times(2)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{}, nil).Return(big.NewInt(11), nil).Times(2)
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(1)).Return(big.NewInt(90), nil).Times(2)
+
+	s.checker.Check(context.Background())
+	time.Sleep(time.Millisecond * 15)
+	s.checker.Check(context.Background())
+
+	s.Nil(s.checker.Ready())
+	s.NotNil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestHealthyIfNoConfirmedBlocksToProcess() {
+	s.mockChain.EXPECT().Polling().Return(true).Times(2)
+	s.mockBlockClient.EXPECT().LatestBlock().Return(big.NewInt(95), nil).Times(2)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{}, nil).Return(big.NewInt(11), nil).Times(2)
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(1)).Return(big.NewInt(90), nil).Times(2)
+
+	s.checker.Check(context.Background())
+	time.Sleep(time.Millisecond * 15)
+	s.checker.Check(context.Background())
+
+	s.Nil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestUnhealthyAfterSystemError() {
+	s.checker.ReportError(errors.New("error"))
+
+	s.NotNil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestUnhealthyAfterChainErrorUntilListenerAdvances() {
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(1)).Return(big.NewInt(90), nil)
+	s.checker.ReportChainError(1, errors.New("error"))
+	s.NotNil(s.checker.Healthy())
+
+	s.mockChain.EXPECT().Polling().Return(true).Times(2)
+	s.mockBlockClient.EXPECT().LatestBlock().Return(big.NewInt(100), nil).Times(2)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{}, nil).Return(big.NewInt(11), nil).Times(2)
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(1)).Return(big.NewInt(90), nil)
+	s.checker.Check(context.Background())
+	s.NotNil(s.checker.Healthy())
+
+	s.mockBlockStorer.EXPECT().GetLastStoredBlock(uint8(1)).Return(big.NewInt(95), nil)
+	s.checker.Check(context.Background())
+	s.Nil(s.checker.Healthy())
+}
+
+func (s *CheckerTestSuite) TestRemovedChainIsNotChecked() {
+	s.checker.RemoveChain(1)
+
+	s.checker.Check(context.Background())
+
+	s.Nil(s.checker.Ready())
+	s.Nil(s.checker.Healthy())
+}
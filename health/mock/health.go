@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./health/health.go
+
+// Package mock_health is a generated GoMock package.
+package mock_health
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPollingChain is a mock of PollingChain interface.
+type MockPollingChain struct {
+	ctrl     *gomock.Controller
+	recorder *MockPollingChainMockRecorder
+}
+
+// MockPollingChainMockRecorder is the mock recorder for MockPollingChain.
+type MockPollingChainMockRecorder struct {
+	mock *MockPollingChain
+}
+
+// NewMockPollingChain creates a new mock instance.
+func NewMockPollingChain(ctrl *gomock.Controller) *MockPollingChain {
+	mock := &MockPollingChain{ctrl: ctrl}
+	mock.recorder = &MockPollingChainMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPollingChain) EXPECT() *MockPollingChainMockRecorder {
+	return m.recorder
+}
+
+// Polling mocks base method.
+func (m *MockPollingChain) Polling() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Polling")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Polling indicates an expected call of Polling.
+func (mr *MockPollingChainMockRecorder) Polling() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Polling", reflect.TypeOf((*MockPollingChain)(nil).Polling))
+}
+
+// MockBlockClient is a mock of BlockClient interface.
+type MockBlockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockClientMockRecorder
+}
+
+// MockBlockClientMockRecorder is the mock recorder for MockBlockClient.
+type MockBlockClientMockRecorder struct {
+	mock *MockBlockClient
+}
+
+// NewMockBlockClient creates a new mock instance.
+func NewMockBlockClient(ctrl *gomock.Controller) *MockBlockClient {
+	mock := &MockBlockClient{ctrl: ctrl}
+	mock.recorder = &MockBlockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockClient) EXPECT() *MockBlockClientMockRecorder {
+	return m.recorder
+}
+
+// LatestBlock mocks base method.
+func (m *MockBlockClient) LatestBlock() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockBlockClientMockRecorder) LatestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockBlockClient)(nil).LatestBlock))
+}
+
+// MockBalanceClient is a mock of BalanceClient interface.
+type MockBalanceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceClientMockRecorder
+}
+
+// MockBalanceClientMockRecorder is the mock recorder for MockBalanceClient.
+type MockBalanceClientMockRecorder struct {
+	mock *MockBalanceClient
+}
+
+// NewMockBalanceClient creates a new mock instance.
+func NewMockBalanceClient(ctrl *gomock.Controller) *MockBalanceClient {
+	mock := &MockBalanceClient{ctrl: ctrl}
+	mock.recorder = &MockBalanceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceClient) EXPECT() *MockBalanceClientMockRecorder {
+	return m.recorder
+}
+
+// BalanceAt mocks base method.
+func (m *MockBalanceClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockBalanceClientMockRecorder) BalanceAt(ctx, account, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockBalanceClient)(nil).BalanceAt), ctx, account, blockNumber)
+}
+
+// MockBlockStorer is a mock of BlockStorer interface.
+type MockBlockStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockStorerMockRecorder
+}
+
+// MockBlockStorerMockRecorder is the mock recorder for MockBlockStorer.
+type MockBlockStorerMockRecorder struct {
+	mock *MockBlockStorer
+}
+
+// NewMockBlockStorer creates a new mock instance.
+func NewMockBlockStorer(ctrl *gomock.Controller) *MockBlockStorer {
+	mock := &MockBlockStorer{ctrl: ctrl}
+	mock.recorder = &MockBlockStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockStorer) EXPECT() *MockBlockStorerMockRecorder {
+	return m.recorder
+}
+
+// GetLastStoredBlock mocks base method.
+func (m *MockBlockStorer) GetLastStoredBlock(domainID uint8) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastStoredBlock", domainID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastStoredBlock indicates an expected call of GetLastStoredBlock.
+func (mr *MockBlockStorerMockRecorder) GetLastStoredBlock(domainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastStoredBlock", reflect.TypeOf((*MockBlockStorer)(nil).GetLastStoredBlock), domainID)
+}
@@ -4,8 +4,11 @@
 package lvldb
 
 import (
+	"bytes"
+
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 type LVLDB struct {
@@ -28,6 +31,47 @@ func (db *LVLDB) SetByKey(key []byte, value []byte) error {
 	return db.db.Put(key, value, nil)
 }
 
+func (db *LVLDB) DeleteByKey(key []byte) error {
+	return db.db.Delete(key, nil)
+}
+
+func (db *LVLDB) WriteBatch(batch *leveldb.Batch) error {
+	return db.db.Write(batch, nil)
+}
+
+func (db *LVLDB) GetByPrefix(prefix []byte) ([][]byte, error) {
+	iter := db.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	values := make([][]byte, 0)
+	for iter.Next() {
+		value := make([]byte, len(iter.Value()))
+		copy(value, iter.Value())
+		values = append(values, value)
+	}
+	return values, iter.Error()
+}
+
+func (db *LVLDB) GetByPrefixFrom(prefix []byte, start []byte, limit int) ([][]byte, error) {
+	r := util.BytesPrefix(prefix)
+	if bytes.Compare(start, r.Start) > 0 {
+		r.Start = start
+	}
+	iter := db.db.NewIterator(r, nil)
+	defer iter.Release()
+
+	values := make([][]byte, 0)
+	for iter.Next() {
+		if limit > 0 && len(values) == limit {
+			break
+		}
+		value := make([]byte, len(iter.Value()))
+		copy(value, iter.Value())
+		values = append(values, value)
+	}
+	return values, iter.Error()
+}
+
 func (db *LVLDB) Close() error {
 	return db.db.Close()
 }
@@ -69,6 +69,10 @@ type RelayerMetrics struct {
 	ExecutionLatencyPerRoute metric.Int64Histogram
 	BlockDelta               metric.Int64ObservableGauge
 	BlockDeltaMap            map[uint8]*big.Int
+	ProposalStatusCount      metric.Int64Counter
+	ProposalVoteCount        metric.Int64Counter
+	RelayerMembership        metric.Int64ObservableGauge
+	RelayerMembershipMap     map[uint8]bool
 
 	lock sync.Mutex
 }
@@ -103,6 +107,19 @@ func NewRelayerMetrics(meter metric.Meter, attributes ...attribute.KeyValue) (*R
 		return nil, err
 	}
 
+	proposalStatusCount, err := meter.Int64Counter(
+		"relayer.ProposalStatusCount",
+		metric.WithDescription("Number of proposal status changes per route and status"))
+	if err != nil {
+		return nil, err
+	}
+	proposalVoteCount, err := meter.Int64Counter(
+		"relayer.ProposalVoteCount",
+		metric.WithDescription("Number of proposal votes per route"))
+	if err != nil {
+		return nil, err
+	}
+
 	blockDeltaMap := make(map[uint8]*big.Int)
 
 	blockDeltaGauge, err := meter.Int64ObservableGauge(
@@ -118,6 +135,29 @@ func NewRelayerMetrics(meter metric.Meter, attributes ...attribute.KeyValue) (*R
 		}),
 		metric.WithDescription("Difference between chain head and current indexed block per domain"),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	relayerMembershipMap := make(map[uint8]bool)
+
+	relayerMembershipGauge, err := meter.Int64ObservableGauge(
+		"relayer.RelayerMembership",
+		metric.WithInt64Callback(func(context context.Context, result metric.Int64Observer) error {
+			for domainID, isRelayer := range relayerMembershipMap {
+				var value int64
+				if isRelayer {
+					value = 1
+				}
+				result.Observe(value,
+					opts,
+					metric.WithAttributes(attribute.Int64("domainID", int64(domainID))),
+				)
+			}
+			return nil
+		}),
+		metric.WithDescription("Whether relayer key is a relayer on the bridge per domain"),
+	)
 	return &RelayerMetrics{
 		meter:                    meter,
 		MessageEventTime:         make(map[string]time.Time),
@@ -128,6 +168,10 @@ func NewRelayerMetrics(meter metric.Meter, attributes ...attribute.KeyValue) (*R
 		ExecutionLatency:         executionLatency,
 		BlockDelta:               blockDeltaGauge,
 		BlockDeltaMap:            blockDeltaMap,
+		ProposalStatusCount:      proposalStatusCount,
+		ProposalVoteCount:        proposalVoteCount,
+		RelayerMembership:        relayerMembershipGauge,
+		RelayerMembershipMap:     relayerMembershipMap,
 	}, err
 }
 
@@ -187,3 +231,34 @@ func (t *RelayerMetrics) TrackBlockDelta(domainID uint8, head *big.Int, current
 
 	t.BlockDeltaMap[domainID] = new(big.Int).Sub(head, current)
 }
+
+// TrackProposalStatus counts proposal status changes on the destination bridge
+func (t *RelayerMetrics) TrackProposalStatus(source uint8, destination uint8, depositNonce uint64, status uint8) {
+	t.ProposalStatusCount.Add(
+		context.Background(),
+		1,
+		t.Opts,
+		api.WithAttributes(attribute.Int64("source", int64(source))),
+		api.WithAttributes(attribute.Int64("destination", int64(destination))),
+		api.WithAttributes(attribute.String("status", message.StatusMap[status])),
+	)
+}
+
+// TrackProposalVote counts votes on proposals on the destination bridge
+func (t *RelayerMetrics) TrackProposalVote(source uint8, destination uint8, depositNonce uint64, status uint8) {
+	t.ProposalVoteCount.Add(
+		context.Background(),
+		1,
+		t.Opts,
+		api.WithAttributes(attribute.Int64("source", int64(source))),
+		api.WithAttributes(attribute.Int64("destination", int64(destination))),
+	)
+}
+
+// TrackRelayerMembership reports if relayer key is allowed to vote on the domain bridge
+func (t *RelayerMetrics) TrackRelayerMembership(domainID uint8, isRelayer bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.RelayerMembershipMap[domainID] = isRelayer
+}
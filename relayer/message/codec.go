@@ -0,0 +1,569 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package message
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CodecVersion is the version of binary and JSON message encoding.
+// Messages encoded with a different version are rejected when decoded.
+const CodecVersion uint8 = 1
+
+// Metadata data values supported by the codec
+const (
+	tagString uint8 = iota + 1
+	tagBool
+	tagInt
+	tagUint
+	tagBytes
+	tagBigInt
+)
+
+var tagNames = map[uint8]string{
+	tagString: "string",
+	tagBool:   "bool",
+	tagInt:    "int",
+	tagUint:   "uint",
+	tagBytes:  "bytes",
+	tagBigInt: "bigint",
+}
+
+// MarshalBinary encodes message with its payload and metadata. Payload values have to be
+// byte slices and metadata values strings, bools, integers, byte slices or big integers.
+// Signed integers are decoded as int64 and unsigned integers as uint64.
+func (m Message) MarshalBinary() ([]byte, error) {
+	w := &bytes.Buffer{}
+	w.WriteByte(CodecVersion)
+	w.WriteByte(m.Source)
+	w.WriteByte(m.Destination)
+	writeUvarint(w, m.DepositNonce)
+	w.Write(m.ResourceId[:])
+	writeBytes(w, []byte(m.Type))
+
+	payload, err := payloadBytes(&m)
+	if err != nil {
+		return nil, err
+	}
+	writeUvarint(w, uint64(len(payload)))
+	for _, p := range payload {
+		writeBytes(w, p)
+	}
+
+	w.WriteByte(m.Metadata.Priority)
+	keys := sortedKeys(m.Metadata.Data)
+	writeUvarint(w, uint64(len(keys)))
+	for _, k := range keys {
+		writeBytes(w, []byte(k))
+		err := writeValue(w, m.Metadata.Data[k])
+		if err != nil {
+			return nil, fmt.Errorf("metadata %s: %w", k, err)
+		}
+	}
+
+	w.Write(m.Origin.TxHash[:])
+	writeUvarint(w, m.Origin.BlockNumber)
+	w.Write(m.Origin.Sender[:])
+	return w.Bytes(), nil
+}
+
+// UnmarshalBinary decodes message encoded with MarshalBinary
+func (m *Message) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	version, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != CodecVersion {
+		return fmt.Errorf("unsupported message codec version %d", version)
+	}
+
+	var decoded Message
+	if decoded.Source, err = r.ReadByte(); err != nil {
+		return err
+	}
+	if decoded.Destination, err = r.ReadByte(); err != nil {
+		return err
+	}
+	if decoded.DepositNonce, err = binary.ReadUvarint(r); err != nil {
+		return err
+	}
+	if _, err = io.ReadFull(r, decoded.ResourceId[:]); err != nil {
+		return err
+	}
+	transferType, err := readBytes(r)
+	if err != nil {
+		return err
+	}
+	decoded.Type = TransferType(transferType)
+
+	payloadLen, err := readLen(r)
+	if err != nil {
+		return err
+	}
+	if payloadLen > 0 {
+		decoded.Payload = make([]interface{}, payloadLen)
+	}
+	for i := range decoded.Payload {
+		if decoded.Payload[i], err = readBytes(r); err != nil {
+			return err
+		}
+	}
+
+	if decoded.Metadata.Priority, err = r.ReadByte(); err != nil {
+		return err
+	}
+	dataLen, err := readLen(r)
+	if err != nil {
+		return err
+	}
+	if dataLen > 0 {
+		decoded.Metadata.Data = make(map[string]interface{}, dataLen)
+	}
+	for i := 0; i < dataLen; i++ {
+		key, err := readBytes(r)
+		if err != nil {
+			return err
+		}
+		value, err := readValue(r)
+		if err != nil {
+			return fmt.Errorf("metadata %s: %w", key, err)
+		}
+		decoded.Metadata.Data[string(key)] = value
+	}
+
+	if _, err = io.ReadFull(r, decoded.Origin.TxHash[:]); err != nil {
+		return err
+	}
+	if decoded.Origin.BlockNumber, err = binary.ReadUvarint(r); err != nil {
+		return err
+	}
+	if _, err = io.ReadFull(r, decoded.Origin.Sender[:]); err != nil {
+		return err
+	}
+	if r.Len() != 0 {
+		return errors.New("unexpected data after message")
+	}
+
+	*m = decoded
+	return nil
+}
+
+type jsonMessage struct {
+	Version      uint8           `json:"version"`
+	Source       uint8           `json:"source"`
+	Destination  uint8           `json:"destination"`
+	DepositNonce uint64          `json:"depositNonce"`
+	ResourceID   hexutil.Bytes   `json:"resourceId"`
+	Type         TransferType    `json:"type"`
+	Payload      json.RawMessage `json:"payload"`
+	Metadata     jsonMetadata    `json:"metadata"`
+	Origin       jsonOrigin      `json:"origin"`
+}
+
+type jsonMetadata struct {
+	Priority uint8                `json:"priority"`
+	Data     map[string]jsonValue `json:"data,omitempty"`
+}
+
+type jsonValue struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type jsonOrigin struct {
+	TxHash      common.Hash    `json:"txHash"`
+	BlockNumber uint64         `json:"blockNumber"`
+	Sender      common.Address `json:"sender"`
+}
+
+type jsonFungiblePayload struct {
+	Amount    *hexutil.Big  `json:"amount"`
+	Recipient hexutil.Bytes `json:"recipient"`
+}
+
+type jsonNonFungiblePayload struct {
+	TokenID   *hexutil.Big  `json:"tokenId"`
+	Recipient hexutil.Bytes `json:"recipient"`
+	Metadata  hexutil.Bytes `json:"metadata"`
+}
+
+type jsonGenericPayload struct {
+	Metadata hexutil.Bytes `json:"metadata"`
+}
+
+// MarshalJSON encodes message with payload of fungible, non fungible and generic
+// transfers as typed objects and payload of other transfers as a list of hex values.
+// Supported payload and metadata values are the same as in MarshalBinary.
+func (m Message) MarshalJSON() ([]byte, error) {
+	payload, err := m.marshalJSONPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := jsonMetadata{Priority: m.Metadata.Priority}
+	if len(m.Metadata.Data) > 0 {
+		metadata.Data = make(map[string]jsonValue, len(m.Metadata.Data))
+		for k, v := range m.Metadata.Data {
+			value, err := marshalJSONValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("metadata %s: %w", k, err)
+			}
+			metadata.Data[k] = value
+		}
+	}
+
+	return json.Marshal(jsonMessage{
+		Version:      CodecVersion,
+		Source:       m.Source,
+		Destination:  m.Destination,
+		DepositNonce: m.DepositNonce,
+		ResourceID:   m.ResourceId[:],
+		Type:         m.Type,
+		Payload:      payload,
+		Metadata:     metadata,
+		Origin:       jsonOrigin(m.Origin),
+	})
+}
+
+// UnmarshalJSON decodes message encoded with MarshalJSON
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var jm jsonMessage
+	err := json.Unmarshal(data, &jm)
+	if err != nil {
+		return err
+	}
+	if jm.Version != CodecVersion {
+		return fmt.Errorf("unsupported message codec version %d", jm.Version)
+	}
+	if len(jm.ResourceID) != len(types.ResourceID{}) {
+		return fmt.Errorf("invalid resourceId length %d", len(jm.ResourceID))
+	}
+
+	decoded := Message{
+		Source:       jm.Source,
+		Destination:  jm.Destination,
+		DepositNonce: jm.DepositNonce,
+		Type:         jm.Type,
+		Metadata:     Metadata{Priority: jm.Metadata.Priority},
+		Origin:       Origin(jm.Origin),
+	}
+	copy(decoded.ResourceId[:], jm.ResourceID)
+
+	decoded.Payload, err = unmarshalJSONPayload(jm.Type, jm.Payload)
+	if err != nil {
+		return err
+	}
+
+	if len(jm.Metadata.Data) > 0 {
+		decoded.Metadata.Data = make(map[string]interface{}, len(jm.Metadata.Data))
+		for k, v := range jm.Metadata.Data {
+			value, err := unmarshalJSONValue(v)
+			if err != nil {
+				return fmt.Errorf("metadata %s: %w", k, err)
+			}
+			decoded.Metadata.Data[k] = value
+		}
+	}
+
+	*m = decoded
+	return nil
+}
+
+func (m *Message) marshalJSONPayload() (json.RawMessage, error) {
+	switch m.Type {
+	case FungibleTransfer:
+		p, err := m.FungiblePayload()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(jsonFungiblePayload{Amount: (*hexutil.Big)(p.Amount), Recipient: p.Recipient})
+	case NonFungibleTransfer:
+		p, err := m.NonFungiblePayload()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(jsonNonFungiblePayload{TokenID: (*hexutil.Big)(p.TokenID), Recipient: p.Recipient, Metadata: p.Metadata})
+	case GenericTransfer:
+		p, err := m.GenericPayload()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(jsonGenericPayload{Metadata: p.Metadata})
+	}
+
+	payload, err := payloadBytes(m)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]hexutil.Bytes, len(payload))
+	for i, p := range payload {
+		values[i] = p
+	}
+	return json.Marshal(values)
+}
+
+func unmarshalJSONPayload(transferType TransferType, data json.RawMessage) ([]interface{}, error) {
+	switch transferType {
+	case FungibleTransfer:
+		var p jsonFungiblePayload
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, err
+		}
+		if p.Amount == nil {
+			return nil, errors.New("missing payload amount")
+		}
+		return FungiblePayload{Amount: p.Amount.ToInt(), Recipient: nilIfEmpty(p.Recipient)}.Payload(), nil
+	case NonFungibleTransfer:
+		var p jsonNonFungiblePayload
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, err
+		}
+		if p.TokenID == nil {
+			return nil, errors.New("missing payload tokenId")
+		}
+		return NonFungiblePayload{TokenID: p.TokenID.ToInt(), Recipient: nilIfEmpty(p.Recipient), Metadata: nilIfEmpty(p.Metadata)}.Payload(), nil
+	case GenericTransfer:
+		var p jsonGenericPayload
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, err
+		}
+		return GenericPayload{Metadata: nilIfEmpty(p.Metadata)}.Payload(), nil
+	}
+
+	var values []hexutil.Bytes
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	payload := make([]interface{}, len(values))
+	for i, v := range values {
+		payload[i] = nilIfEmpty(v)
+	}
+	return payload, nil
+}
+
+func marshalJSONValue(v interface{}) (jsonValue, error) {
+	tag, v, err := normalizeValue(v)
+	if err != nil {
+		return jsonValue{}, err
+	}
+
+	var value []byte
+	switch tag {
+	case tagBytes:
+		value, err = json.Marshal(hexutil.Bytes(v.([]byte)))
+	case tagBigInt:
+		value, err = json.Marshal(v.(*big.Int).String())
+	default:
+		value, err = json.Marshal(v)
+	}
+	return jsonValue{Type: tagNames[tag], Value: value}, err
+}
+
+func unmarshalJSONValue(v jsonValue) (interface{}, error) {
+	switch v.Type {
+	case tagNames[tagString]:
+		var s string
+		err := json.Unmarshal(v.Value, &s)
+		return s, err
+	case tagNames[tagBool]:
+		var b bool
+		err := json.Unmarshal(v.Value, &b)
+		return b, err
+	case tagNames[tagInt]:
+		var i int64
+		err := json.Unmarshal(v.Value, &i)
+		return i, err
+	case tagNames[tagUint]:
+		var u uint64
+		err := json.Unmarshal(v.Value, &u)
+		return u, err
+	case tagNames[tagBytes]:
+		var b hexutil.Bytes
+		err := json.Unmarshal(v.Value, &b)
+		return nilIfEmpty(b), err
+	case tagNames[tagBigInt]:
+		var s string
+		if err := json.Unmarshal(v.Value, &s); err != nil {
+			return nil, err
+		}
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid bigint %s", s)
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %s", v.Type)
+}
+
+// normalizeValue converts metadata value to the type it is decoded as
+func normalizeValue(v interface{}) (uint8, interface{}, error) {
+	switch value := v.(type) {
+	case string:
+		return tagString, value, nil
+	case bool:
+		return tagBool, value, nil
+	case int:
+		return tagInt, int64(value), nil
+	case int64:
+		return tagInt, value, nil
+	case uint8:
+		return tagUint, uint64(value), nil
+	case uint:
+		return tagUint, uint64(value), nil
+	case uint64:
+		return tagUint, value, nil
+	case []byte:
+		return tagBytes, value, nil
+	case *big.Int:
+		if value == nil {
+			break
+		}
+		return tagBigInt, value, nil
+	}
+	return 0, nil, fmt.Errorf("unsupported value %T", v)
+}
+
+func writeValue(w *bytes.Buffer, v interface{}) error {
+	tag, v, err := normalizeValue(v)
+	if err != nil {
+		return err
+	}
+
+	w.WriteByte(tag)
+	switch tag {
+	case tagString:
+		writeBytes(w, []byte(v.(string)))
+	case tagBool:
+		if v.(bool) {
+			w.WriteByte(1)
+		} else {
+			w.WriteByte(0)
+		}
+	case tagInt:
+		buf := make([]byte, binary.MaxVarintLen64)
+		w.Write(buf[:binary.PutVarint(buf, v.(int64))])
+	case tagUint:
+		writeUvarint(w, v.(uint64))
+	case tagBytes:
+		writeBytes(w, v.([]byte))
+	case tagBigInt:
+		n := v.(*big.Int)
+		w.WriteByte(byte(n.Sign() + 1))
+		writeBytes(w, n.Bytes())
+	}
+	return nil
+}
+
+func readValue(r *bytes.Reader) (interface{}, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagString:
+		b, err := readBytes(r)
+		return string(b), err
+	case tagBool:
+		b, err := r.ReadByte()
+		return b == 1, err
+	case tagInt:
+		return binary.ReadVarint(r)
+	case tagUint:
+		return binary.ReadUvarint(r)
+	case tagBytes:
+		return readBytes(r)
+	case tagBigInt:
+		sign, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		b, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+		n := new(big.Int).SetBytes(b)
+		if sign == 0 {
+			n.Neg(n)
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("unsupported value tag %d", tag)
+}
+
+func payloadBytes(m *Message) ([][]byte, error) {
+	payload := make([][]byte, len(m.Payload))
+	for i, p := range m.Payload {
+		b, ok := p.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("unsupported payload value %T at index %d", p, i)
+		}
+		payload[i] = b
+	}
+	return payload, nil
+}
+
+// nilIfEmpty decodes empty byte slices as nil, same as readBytes
+func nilIfEmpty(b []byte) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
+
+func sortedKeys(data map[string]interface{}) []string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeUvarint(w *bytes.Buffer, v uint64) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	w.Write(buf[:binary.PutUvarint(buf, v)])
+}
+
+func writeBytes(w *bytes.Buffer, b []byte) {
+	writeUvarint(w, uint64(len(b)))
+	w.Write(b)
+}
+
+func readLen(r *bytes.Reader) (int, error) {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	if l > uint64(r.Len()) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return int(l), nil
+}
+
+// readBytes decodes empty byte slices as nil
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	l, err := readLen(r)
+	if err != nil || l == 0 {
+		return nil, err
+	}
+	b := make([]byte, l)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
@@ -0,0 +1,126 @@
+package message
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func testMessages() []*Message {
+	fungible := NewMessage(1, 2, 3, types.ResourceID{1}, FungibleTransfer, FungiblePayload{Amount: big.NewInt(100), Recipient: []byte{1, 2}}.Payload(), Metadata{
+		Priority: 1,
+		Data: map[string]interface{}{
+			"string": "value",
+			"bool":   true,
+			"int":    int64(-5),
+			"uint":   uint64(5),
+			"bytes":  []byte{1},
+			"bigint": big.NewInt(-100),
+		},
+	})
+	fungible.Origin = Origin{TxHash: common.HexToHash("0x1"), BlockNumber: 10, Sender: common.HexToAddress("0x2")}
+	return []*Message{
+		fungible,
+		NewMessage(1, 2, 4, types.ResourceID{2}, NonFungibleTransfer, NonFungiblePayload{TokenID: big.NewInt(7), Recipient: []byte{3}}.Payload(), Metadata{}),
+		NewMessage(1, 2, 5, types.ResourceID{3}, GenericTransfer, GenericPayload{Metadata: []byte{4, 5}}.Payload(), Metadata{}),
+		NewMessage(1, 2, 6, types.ResourceID{4}, "CustomTransfer", []interface{}{[]byte{6}, []byte(nil)}, Metadata{}),
+		{Source: 1, Destination: 2, DepositNonce: 7},
+	}
+}
+
+func TestBinaryCodecRoundTrip(t *testing.T) {
+	for i, m := range testMessages() {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		var decoded Message
+		err = decoded.UnmarshalBinary(data)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if !reflect.DeepEqual(*m, decoded) {
+			t.Fatalf("%d: expected %+v, got %+v", i, *m, decoded)
+		}
+	}
+}
+
+func TestJSONCodecRoundTrip(t *testing.T) {
+	for i, m := range testMessages() {
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		var decoded *Message
+		err = json.Unmarshal(data, &decoded)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if !reflect.DeepEqual(m, decoded) {
+			t.Fatalf("%d: expected %+v, got %+v", i, m, decoded)
+		}
+	}
+}
+
+func TestJSONCodecTypedPayload(t *testing.T) {
+	m := NewMessage(1, 2, 3, types.ResourceID{}, FungibleTransfer, FungiblePayload{Amount: big.NewInt(255), Recipient: []byte{1}}.Payload(), Metadata{})
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var encoded struct {
+		Payload map[string]string
+	}
+	_ = json.Unmarshal(data, &encoded)
+	if encoded.Payload["amount"] != "0xff" || encoded.Payload["recipient"] != "0x01" {
+		t.Fatalf("unexpected payload %v", encoded.Payload)
+	}
+}
+
+func TestCodecRejectsUnsupportedValues(t *testing.T) {
+	m := &Message{Payload: []interface{}{"string"}}
+	if _, err := m.MarshalBinary(); err == nil {
+		t.Fatal("expected unsupported payload error")
+	}
+
+	m = &Message{Metadata: Metadata{Data: map[string]interface{}{"float": 1.5}}}
+	if _, err := json.Marshal(m); err == nil {
+		t.Fatal("expected unsupported metadata error")
+	}
+}
+
+func TestCodecRejectsOtherVersions(t *testing.T) {
+	data, _ := (&Message{}).MarshalBinary()
+	data[0] = CodecVersion + 1
+
+	var m Message
+	if err := m.UnmarshalBinary(data); err == nil {
+		t.Fatal("expected version error")
+	}
+	if err := json.Unmarshal([]byte(`{"version":2}`), &m); err == nil {
+		t.Fatal("expected version error")
+	}
+}
+
+func TestTypedPayloadMalformed(t *testing.T) {
+	m := &Message{Payload: []interface{}{[]byte{1}}}
+	if _, err := m.FungiblePayload(); err == nil {
+		t.Fatal("expected malformed payload error")
+	}
+	m = &Message{Payload: []interface{}{[]byte{1}, "recipient", []byte{}}}
+	if _, err := m.NonFungiblePayload(); err == nil {
+		t.Fatal("expected wrong recipient format error")
+	}
+	m = &Message{Payload: []interface{}{1}}
+	if _, err := m.GenericPayload(); err == nil {
+		t.Fatal("expected wrong metadata format error")
+	}
+}
@@ -4,12 +4,26 @@
 package message
 
 import (
+	"errors"
 	"math/big"
 	"strconv"
 
 	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+// ErrMessageFiltered is returned by message processors for messages that
+// should not be relayed
+var ErrMessageFiltered = errors.New("message filtered")
+
+// ErrMessageRetracted is the execution result of messages that were read
+// from blocks removed from the source chain by a reorganization
+var ErrMessageRetracted = errors.New("message retracted")
+
+// ErrMessageAlreadyQueued is the execution result of messages that are already
+// waiting for or in execution, e.g. when redelivered after the source chain restarted
+var ErrMessageAlreadyQueued = errors.New("message already queued")
+
 type TransferType string
 type Metadata struct {
 	Priority uint8
@@ -46,9 +60,17 @@ type Message struct {
 	Destination  uint8  // Destination chain of message
 	DepositNonce uint64 // Nonce for the deposit
 	ResourceId   types.ResourceID
-	Payload      []interface{} // data associated with event sequence
+	Payload      []interface{} // deposit data as positional byte values, read typed with FungiblePayload and similar
 	Metadata     Metadata      // Arbitrary data that will be most likely be used by the relayer
 	Type         TransferType
+	Origin       Origin // Source chain deposit the message was created from
+}
+
+// Origin describes the deposit transaction on the source chain
+type Origin struct {
+	TxHash      common.Hash
+	BlockNumber uint64
+	Sender      common.Address
 }
 
 func NewMessage(
@@ -68,9 +90,16 @@ func NewMessage(
 		payload,
 		metadata,
 		transferType,
+		Origin{},
 	}
 }
 
 func (m Message) ID() string {
 	return strconv.FormatInt(int64(m.Source), 10) + "-" + strconv.FormatInt(int64(m.DepositNonce), 10)
 }
+
+// ExecutionResult is the outcome of executing message on the destination chain
+type ExecutionResult struct {
+	Message *Message
+	Err     error
+}
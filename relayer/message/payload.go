@@ -0,0 +1,112 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package message
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FungiblePayload is payload of FungibleTransfer messages. Typed payloads are
+// views of Message.Payload, which keeps payload as positional byte values:
+// Payload builds the byte values and Message methods read them back.
+type FungiblePayload struct {
+	Amount    *big.Int
+	Recipient []byte
+}
+
+// Payload converts typed payload into message payload
+func (p FungiblePayload) Payload() []interface{} {
+	return []interface{}{
+		common.LeftPadBytes(p.Amount.Bytes(), 32),
+		p.Recipient,
+	}
+}
+
+// NonFungiblePayload is payload of NonFungibleTransfer messages
+type NonFungiblePayload struct {
+	TokenID   *big.Int
+	Recipient []byte
+	Metadata  []byte
+}
+
+// Payload converts typed payload into message payload
+func (p NonFungiblePayload) Payload() []interface{} {
+	return []interface{}{
+		common.LeftPadBytes(p.TokenID.Bytes(), 32),
+		p.Recipient,
+		p.Metadata,
+	}
+}
+
+// GenericPayload is payload of GenericTransfer messages
+type GenericPayload struct {
+	Metadata []byte
+}
+
+// Payload converts typed payload into message payload
+func (p GenericPayload) Payload() []interface{} {
+	return []interface{}{
+		p.Metadata,
+	}
+}
+
+// FungiblePayload returns message payload as FungiblePayload
+func (m *Message) FungiblePayload() (FungiblePayload, error) {
+	if len(m.Payload) != 2 {
+		return FungiblePayload{}, errors.New("malformed payload. Len  of payload should be 2")
+	}
+	amount, ok := m.Payload[0].([]byte)
+	if !ok {
+		return FungiblePayload{}, errors.New("wrong payload amount format")
+	}
+	recipient, ok := m.Payload[1].([]byte)
+	if !ok {
+		return FungiblePayload{}, errors.New("wrong payload recipient format")
+	}
+	return FungiblePayload{
+		Amount:    new(big.Int).SetBytes(amount),
+		Recipient: recipient,
+	}, nil
+}
+
+// NonFungiblePayload returns message payload as NonFungiblePayload
+func (m *Message) NonFungiblePayload() (NonFungiblePayload, error) {
+	if len(m.Payload) != 3 {
+		return NonFungiblePayload{}, errors.New("malformed payload. Len  of payload should be 3")
+	}
+	tokenID, ok := m.Payload[0].([]byte)
+	if !ok {
+		return NonFungiblePayload{}, errors.New("wrong payload tokenID format")
+	}
+	recipient, ok := m.Payload[1].([]byte)
+	if !ok {
+		return NonFungiblePayload{}, errors.New("wrong payload recipient format")
+	}
+	metadata, ok := m.Payload[2].([]byte)
+	if !ok {
+		return NonFungiblePayload{}, errors.New("wrong payload metadata format")
+	}
+	return NonFungiblePayload{
+		TokenID:   new(big.Int).SetBytes(tokenID),
+		Recipient: recipient,
+		Metadata:  metadata,
+	}, nil
+}
+
+// GenericPayload returns message payload as GenericPayload
+func (m *Message) GenericPayload() (GenericPayload, error) {
+	if len(m.Payload) != 1 {
+		return GenericPayload{}, errors.New("malformed payload. Len  of payload should be 1")
+	}
+	metadata, ok := m.Payload[0].([]byte)
+	if !ok {
+		return GenericPayload{}, errors.New("wrong payload metadata format")
+	}
+	return GenericPayload{
+		Metadata: metadata,
+	}, nil
+}
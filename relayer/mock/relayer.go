@@ -121,15 +121,159 @@ func (mr *MockRelayedChainMockRecorder) PollEvents(ctx, sysErr, msgChan interfac
 }
 
 // Write mocks base method.
-func (m *MockRelayedChain) Write(messages []*message.Message) error {
+func (m *MockRelayedChain) Write(messages []*message.Message, results chan<- *message.ExecutionResult) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Write", messages)
+	ret := m.ctrl.Call(m, "Write", messages, results)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Write indicates an expected call of Write.
-func (mr *MockRelayedChainMockRecorder) Write(messages interface{}) *gomock.Call {
+func (mr *MockRelayedChainMockRecorder) Write(messages, results interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockRelayedChain)(nil).Write), messages)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockRelayedChain)(nil).Write), messages, results)
+}
+
+// MockMessageOutbox is a mock of MessageOutbox interface.
+type MockMessageOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageOutboxMockRecorder
+}
+
+// MockMessageOutboxMockRecorder is the mock recorder for MockMessageOutbox.
+type MockMessageOutboxMockRecorder struct {
+	mock *MockMessageOutbox
+}
+
+// NewMockMessageOutbox creates a new mock instance.
+func NewMockMessageOutbox(ctrl *gomock.Controller) *MockMessageOutbox {
+	mock := &MockMessageOutbox{ctrl: ctrl}
+	mock.recorder = &MockMessageOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageOutbox) EXPECT() *MockMessageOutboxMockRecorder {
+	return m.recorder
+}
+
+// MarkDone mocks base method.
+func (m_2 *MockMessageOutbox) MarkDone(m *message.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "MarkDone", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDone indicates an expected call of MarkDone.
+func (mr *MockMessageOutboxMockRecorder) MarkDone(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDone", reflect.TypeOf((*MockMessageOutbox)(nil).MarkDone), m)
+}
+
+// MockGracefulChain is a mock of GracefulChain interface.
+type MockGracefulChain struct {
+	ctrl     *gomock.Controller
+	recorder *MockGracefulChainMockRecorder
+}
+
+// MockGracefulChainMockRecorder is the mock recorder for MockGracefulChain.
+type MockGracefulChainMockRecorder struct {
+	mock *MockGracefulChain
+}
+
+// NewMockGracefulChain creates a new mock instance.
+func NewMockGracefulChain(ctrl *gomock.Controller) *MockGracefulChain {
+	mock := &MockGracefulChain{ctrl: ctrl}
+	mock.recorder = &MockGracefulChainMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGracefulChain) EXPECT() *MockGracefulChainMockRecorder {
+	return m.recorder
+}
+
+// Wait mocks base method.
+func (m *MockGracefulChain) Wait(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockGracefulChainMockRecorder) Wait(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockGracefulChain)(nil).Wait), ctx)
+}
+
+// MockChainErrorReporter is a mock of ChainErrorReporter interface.
+type MockChainErrorReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainErrorReporterMockRecorder
+}
+
+// MockChainErrorReporterMockRecorder is the mock recorder for MockChainErrorReporter.
+type MockChainErrorReporterMockRecorder struct {
+	mock *MockChainErrorReporter
+}
+
+// NewMockChainErrorReporter creates a new mock instance.
+func NewMockChainErrorReporter(ctrl *gomock.Controller) *MockChainErrorReporter {
+	mock := &MockChainErrorReporter{ctrl: ctrl}
+	mock.recorder = &MockChainErrorReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChainErrorReporter) EXPECT() *MockChainErrorReporterMockRecorder {
+	return m.recorder
+}
+
+// ReportChainError mocks base method.
+func (m *MockChainErrorReporter) ReportChainError(domainID uint8, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportChainError", domainID, err)
+}
+
+// ReportChainError indicates an expected call of ReportChainError.
+func (mr *MockChainErrorReporterMockRecorder) ReportChainError(domainID, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportChainError", reflect.TypeOf((*MockChainErrorReporter)(nil).ReportChainError), domainID, err)
+}
+
+// MockRetractingChain is a mock of RetractingChain interface.
+type MockRetractingChain struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetractingChainMockRecorder
+}
+
+// MockRetractingChainMockRecorder is the mock recorder for MockRetractingChain.
+type MockRetractingChainMockRecorder struct {
+	mock *MockRetractingChain
+}
+
+// NewMockRetractingChain creates a new mock instance.
+func NewMockRetractingChain(ctrl *gomock.Controller) *MockRetractingChain {
+	mock := &MockRetractingChain{ctrl: ctrl}
+	mock.recorder = &MockRetractingChainMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetractingChain) EXPECT() *MockRetractingChainMockRecorder {
+	return m.recorder
+}
+
+// Retract mocks base method.
+func (m *MockRetractingChain) Retract(msgs []*message.Message) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Retract", msgs)
+}
+
+// Retract indicates an expected call of Retract.
+func (mr *MockRetractingChainMockRecorder) Retract(msgs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retract", reflect.TypeOf((*MockRetractingChain)(nil).Retract), msgs)
 }
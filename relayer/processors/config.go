@@ -0,0 +1,60 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package processors
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-core/config/relayer"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+)
+
+// NewProcessors creates processors enabled in the config. Filtering processors
+// run before metadata enrichment.
+func NewProcessors(config relayer.ProcessorsConfig) ([]message.MessageProcessor, error) {
+	processors := make([]message.MessageProcessor, 0)
+	if len(config.ResourceAllowlist) > 0 {
+		processors = append(processors, ResourceAllowlistProcessor(config.ResourceAllowlist))
+	}
+	if len(config.ResourceDenylist) > 0 {
+		processors = append(processors, ResourceDenylistProcessor(config.ResourceDenylist))
+	}
+	if len(config.DisabledRoutes) > 0 {
+		routes := make([]Route, len(config.DisabledRoutes))
+		for i, r := range config.DisabledRoutes {
+			routes[i] = Route{Source: r.Source, Destination: r.Destination}
+		}
+		processors = append(processors, DisabledRoutesProcessor(routes))
+	}
+	if len(config.MaxAmounts) > 0 {
+		processors = append(processors, MaxAmountProcessor(config.MaxAmounts))
+	}
+	if config.RecipientDenylistFile != "" {
+		recipients, err := LoadRecipientDenylist(config.RecipientDenylistFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load recipient denylist: %w", err)
+		}
+		processors = append(processors, RecipientDenylistProcessor(recipients))
+	}
+	if len(config.Rules) > 0 {
+		rules := make([]*Rule, len(config.Rules))
+		for i, r := range config.Rules {
+			rule, err := NewRule(r.Name, r.Expression, config.Lists)
+			if err != nil {
+				return nil, err
+			}
+			rules[i] = rule
+		}
+		processors = append(processors, RuleProcessor(rules))
+	}
+	if len(config.MetadataFields) > 0 {
+		for _, f := range config.MetadataFields {
+			if f != TxHashField && f != BlockNumberField && f != SenderField {
+				return nil, fmt.Errorf("unknown metadata field %s", f)
+			}
+		}
+		processors = append(processors, MetadataEnrichmentProcessor(config.MetadataFields))
+	}
+	return processors, nil
+}
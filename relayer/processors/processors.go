@@ -0,0 +1,178 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package processors
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/types"
+)
+
+// Metadata fields that can be attached by MetadataEnrichmentProcessor
+const (
+	TxHashField      = "txHash"
+	BlockNumberField = "blockNumber"
+	SenderField      = "sender"
+)
+
+// Route is a direction of transfers between two domains
+type Route struct {
+	Source      uint8
+	Destination uint8
+}
+
+// ResourceAllowlistProcessor filters messages with resource IDs that are not in resourceIDs
+func ResourceAllowlistProcessor(resourceIDs []types.ResourceID) message.MessageProcessor {
+	allowed := resourceSet(resourceIDs)
+	return func(m *message.Message) error {
+		if _, ok := allowed[m.ResourceId]; !ok {
+			return fmt.Errorf("%w: resource %x is not allowed", message.ErrMessageFiltered, m.ResourceId)
+		}
+		return nil
+	}
+}
+
+// ResourceDenylistProcessor filters messages with resource IDs that are in resourceIDs
+func ResourceDenylistProcessor(resourceIDs []types.ResourceID) message.MessageProcessor {
+	denied := resourceSet(resourceIDs)
+	return func(m *message.Message) error {
+		if _, ok := denied[m.ResourceId]; ok {
+			return fmt.Errorf("%w: resource %x is denied", message.ErrMessageFiltered, m.ResourceId)
+		}
+		return nil
+	}
+}
+
+// DisabledRoutesProcessor filters messages sent over disabled routes
+func DisabledRoutesProcessor(routes []Route) message.MessageProcessor {
+	disabled := make(map[Route]struct{}, len(routes))
+	for _, r := range routes {
+		disabled[r] = struct{}{}
+	}
+	return func(m *message.Message) error {
+		if _, ok := disabled[Route{Source: m.Source, Destination: m.Destination}]; ok {
+			return fmt.Errorf("%w: route %d->%d is disabled", message.ErrMessageFiltered, m.Source, m.Destination)
+		}
+		return nil
+	}
+}
+
+// MaxAmountProcessor filters ERC20 transfers with amount above the maximum
+// configured for its resource. Transfers of resources without a maximum are not limited.
+func MaxAmountProcessor(maxAmounts map[types.ResourceID]*big.Int) message.MessageProcessor {
+	return func(m *message.Message) error {
+		if m.Type != message.FungibleTransfer {
+			return nil
+		}
+		maxAmount, ok := maxAmounts[m.ResourceId]
+		if !ok {
+			return nil
+		}
+
+		payload, err := m.FungiblePayload()
+		if err != nil {
+			return err
+		}
+		if payload.Amount.Cmp(maxAmount) == 1 {
+			return fmt.Errorf("%w: amount %s exceeds maximum %s", message.ErrMessageFiltered, payload.Amount, maxAmount)
+		}
+		return nil
+	}
+}
+
+// RecipientDenylistProcessor filters ERC20 and ERC721 transfers to denied recipients
+func RecipientDenylistProcessor(recipients [][]byte) message.MessageProcessor {
+	denied := make(map[string]struct{}, len(recipients))
+	for _, r := range recipients {
+		denied[string(r)] = struct{}{}
+	}
+	return func(m *message.Message) error {
+		var recipient []byte
+		switch m.Type {
+		case message.FungibleTransfer:
+			payload, err := m.FungiblePayload()
+			if err != nil {
+				return err
+			}
+			recipient = payload.Recipient
+		case message.NonFungibleTransfer:
+			payload, err := m.NonFungiblePayload()
+			if err != nil {
+				return err
+			}
+			recipient = payload.Recipient
+		default:
+			return nil
+		}
+
+		if _, ok := denied[string(recipient)]; ok {
+			return fmt.Errorf("%w: recipient %x is denied", message.ErrMessageFiltered, recipient)
+		}
+		return nil
+	}
+}
+
+// LoadRecipientDenylist reads hex encoded recipients from the file, one per line.
+// Empty lines and lines starting with # are skipped.
+func LoadRecipientDenylist(path string) ([][]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	recipients := make([][]byte, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		recipient, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(line), "0x"))
+		if err != nil || len(recipient) == 0 {
+			return nil, fmt.Errorf("invalid recipient %s", line)
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, scanner.Err()
+}
+
+// MetadataEnrichmentProcessor attaches fields describing the source deposit
+// to message metadata
+func MetadataEnrichmentProcessor(fields []string) message.MessageProcessor {
+	return func(m *message.Message) error {
+		if m.Metadata.Data == nil {
+			m.Metadata.Data = make(map[string]interface{})
+		}
+
+		for _, f := range fields {
+			switch f {
+			case TxHashField:
+				m.Metadata.Data[TxHashField] = m.Origin.TxHash.Hex()
+			case BlockNumberField:
+				m.Metadata.Data[BlockNumberField] = m.Origin.BlockNumber
+			case SenderField:
+				m.Metadata.Data[SenderField] = m.Origin.Sender.Hex()
+			default:
+				return fmt.Errorf("unknown metadata field %s", f)
+			}
+		}
+		return nil
+	}
+}
+
+func resourceSet(resourceIDs []types.ResourceID) map[types.ResourceID]struct{} {
+	set := make(map[types.ResourceID]struct{}, len(resourceIDs))
+	for _, id := range resourceIDs {
+		set[id] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,138 @@
+package processors_test
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/config/relayer"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/processors"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProcessorsTestSuite struct {
+	suite.Suite
+	resourceID types.ResourceID
+	recipient  []byte
+}
+
+func TestRunProcessorsTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorsTestSuite))
+}
+
+func (s *ProcessorsTestSuite) SetupTest() {
+	s.resourceID = types.ResourceID{1}
+	s.recipient = common.HexToAddress("0x1").Bytes()
+}
+
+func (s *ProcessorsTestSuite) transfer(amount int64) *message.Message {
+	return &message.Message{
+		Source:      1,
+		Destination: 2,
+		ResourceId:  s.resourceID,
+		Type:        message.FungibleTransfer,
+		Payload:     []interface{}{big.NewInt(amount).Bytes(), s.recipient},
+	}
+}
+
+func (s *ProcessorsTestSuite) TestResourceAllowlist() {
+	s.Nil(processors.ResourceAllowlistProcessor([]types.ResourceID{s.resourceID})(s.transfer(1)))
+
+	err := processors.ResourceAllowlistProcessor([]types.ResourceID{{2}})(s.transfer(1))
+
+	s.True(errors.Is(err, message.ErrMessageFiltered))
+}
+
+func (s *ProcessorsTestSuite) TestResourceDenylist() {
+	s.Nil(processors.ResourceDenylistProcessor([]types.ResourceID{{2}})(s.transfer(1)))
+
+	err := processors.ResourceDenylistProcessor([]types.ResourceID{s.resourceID})(s.transfer(1))
+
+	s.True(errors.Is(err, message.ErrMessageFiltered))
+}
+
+func (s *ProcessorsTestSuite) TestDisabledRoutes() {
+	s.Nil(processors.DisabledRoutesProcessor([]processors.Route{{Source: 2, Destination: 1}})(s.transfer(1)))
+
+	err := processors.DisabledRoutesProcessor([]processors.Route{{Source: 1, Destination: 2}})(s.transfer(1))
+
+	s.True(errors.Is(err, message.ErrMessageFiltered))
+}
+
+func (s *ProcessorsTestSuite) TestMaxAmount() {
+	mp := processors.MaxAmountProcessor(map[types.ResourceID]*big.Int{s.resourceID: big.NewInt(100)})
+
+	s.Nil(mp(s.transfer(100)))
+	s.True(errors.Is(mp(s.transfer(101)), message.ErrMessageFiltered))
+}
+
+func (s *ProcessorsTestSuite) TestMaxAmountIgnoresOtherResources() {
+	mp := processors.MaxAmountProcessor(map[types.ResourceID]*big.Int{{2}: big.NewInt(100)})
+
+	s.Nil(mp(s.transfer(101)))
+}
+
+func (s *ProcessorsTestSuite) TestRecipientDenylist() {
+	s.Nil(processors.RecipientDenylistProcessor([][]byte{common.HexToAddress("0x2").Bytes()})(s.transfer(1)))
+
+	err := processors.RecipientDenylistProcessor([][]byte{s.recipient})(s.transfer(1))
+
+	s.True(errors.Is(err, message.ErrMessageFiltered))
+}
+
+func (s *ProcessorsTestSuite) TestRecipientDenylistIgnoresGenericTransfers() {
+	m := &message.Message{Type: message.GenericTransfer, Payload: []interface{}{[]byte{1}}}
+
+	s.Nil(processors.RecipientDenylistProcessor([][]byte{s.recipient})(m))
+}
+
+func (s *ProcessorsTestSuite) TestLoadRecipientDenylist() {
+	_ = os.WriteFile("denylist.txt", []byte("# denied\n\n0x0000000000000000000000000000000000000001\n"), 0644)
+	defer os.Remove("denylist.txt")
+
+	recipients, err := processors.LoadRecipientDenylist("denylist.txt")
+
+	s.Nil(err)
+	s.Equal([][]byte{s.recipient}, recipients)
+}
+
+func (s *ProcessorsTestSuite) TestLoadRecipientDenylistInvalidRecipient() {
+	_ = os.WriteFile("denylist.txt", []byte("invalid\n"), 0644)
+	defer os.Remove("denylist.txt")
+
+	_, err := processors.LoadRecipientDenylist("denylist.txt")
+
+	s.NotNil(err)
+}
+
+func (s *ProcessorsTestSuite) TestMetadataEnrichment() {
+	m := s.transfer(1)
+	m.Origin = message.Origin{TxHash: common.HexToHash("0xabc"), BlockNumber: 10, Sender: common.HexToAddress("0x3")}
+
+	err := processors.MetadataEnrichmentProcessor([]string{processors.TxHashField, processors.BlockNumberField, processors.SenderField})(m)
+
+	s.Nil(err)
+	s.Equal(common.HexToHash("0xabc").Hex(), m.Metadata.Data[processors.TxHashField])
+	s.Equal(uint64(10), m.Metadata.Data[processors.BlockNumberField])
+	s.Equal(common.HexToAddress("0x3").Hex(), m.Metadata.Data[processors.SenderField])
+}
+
+func (s *ProcessorsTestSuite) TestNewProcessorsUnknownMetadataField() {
+	_, err := processors.NewProcessors(relayer.ProcessorsConfig{MetadataFields: []string{"invalid"}})
+
+	s.NotNil(err)
+}
+
+func (s *ProcessorsTestSuite) TestNewProcessors() {
+	mps, err := processors.NewProcessors(relayer.ProcessorsConfig{
+		ResourceDenylist: []types.ResourceID{s.resourceID},
+		MetadataFields:   []string{processors.TxHashField},
+	})
+
+	s.Nil(err)
+	s.Len(mps, 2)
+}
@@ -0,0 +1,473 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package processors
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+)
+
+// errUndefined is returned when a rule references a field or metadata
+// that is not defined for the message
+var errUndefined = errors.New("undefined")
+
+type kind int
+
+const (
+	kindBool kind = iota
+	kindNumber
+	kindString
+	// kindAny is a metadata value whose type is known only when rule is evaluated
+	kindAny
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindBool:
+		return "bool"
+	case kindNumber:
+		return "number"
+	case kindString:
+		return "string"
+	default:
+		return "any"
+	}
+}
+
+type field struct {
+	kind  kind
+	value func(m *message.Message) (interface{}, error)
+}
+
+// fields are message fields that can be referenced in rules. Hex values are
+// lowercase and prefixed with 0x.
+var fields = map[string]field{
+	"source":       {kindNumber, func(m *message.Message) (interface{}, error) { return big.NewInt(int64(m.Source)), nil }},
+	"destination":  {kindNumber, func(m *message.Message) (interface{}, error) { return big.NewInt(int64(m.Destination)), nil }},
+	"depositNonce": {kindNumber, func(m *message.Message) (interface{}, error) { return new(big.Int).SetUint64(m.DepositNonce), nil }},
+	"resourceId":   {kindString, func(m *message.Message) (interface{}, error) { return hexString(m.ResourceId[:]), nil }},
+	"transferType": {kindString, func(m *message.Message) (interface{}, error) { return string(m.Type), nil }},
+	"priority":     {kindNumber, func(m *message.Message) (interface{}, error) { return big.NewInt(int64(m.Metadata.Priority)), nil }},
+	"sender":       {kindString, func(m *message.Message) (interface{}, error) { return hexString(m.Origin.Sender[:]), nil }},
+	"txHash":       {kindString, func(m *message.Message) (interface{}, error) { return hexString(m.Origin.TxHash[:]), nil }},
+	"blockNumber": {kindNumber, func(m *message.Message) (interface{}, error) {
+		return new(big.Int).SetUint64(m.Origin.BlockNumber), nil
+	}},
+	"amount": {kindNumber, func(m *message.Message) (interface{}, error) {
+		if m.Type != message.FungibleTransfer {
+			return nil, fmt.Errorf("amount is %w for %s", errUndefined, m.Type)
+		}
+		payload, err := m.FungiblePayload()
+		if err != nil {
+			return nil, err
+		}
+		return payload.Amount, nil
+	}},
+	"tokenId": {kindNumber, func(m *message.Message) (interface{}, error) {
+		if m.Type != message.NonFungibleTransfer {
+			return nil, fmt.Errorf("tokenId is %w for %s", errUndefined, m.Type)
+		}
+		payload, err := m.NonFungiblePayload()
+		if err != nil {
+			return nil, err
+		}
+		return payload.TokenID, nil
+	}},
+	"recipient": {kindString, func(m *message.Message) (interface{}, error) {
+		switch m.Type {
+		case message.FungibleTransfer:
+			payload, err := m.FungiblePayload()
+			if err != nil {
+				return nil, err
+			}
+			return hexString(payload.Recipient), nil
+		case message.NonFungibleTransfer:
+			payload, err := m.NonFungiblePayload()
+			if err != nil {
+				return nil, err
+			}
+			return hexString(payload.Recipient), nil
+		}
+		return nil, fmt.Errorf("recipient is %w for %s", errUndefined, m.Type)
+	}},
+}
+
+// Rule is a boolean expression evaluated against messages. Expressions use Go syntax
+// limited to message fields, number and string literals, metadata["key"] lookups,
+// comparison and logical operators and in(value, "list") checks against named lists.
+// For example:
+//
+//	resourceId == "0x00..01" && amount > 1e24 && destination == 3 && !in(sender, "trusted")
+//
+// String comparisons and list names are case insensitive. Rule doesn't match
+// messages for which it references an undefined field, e.g. amount of
+// a generic message or a missing metadata key.
+type Rule struct {
+	Name  string
+	expr  ast.Expr
+	lists map[string]map[string]struct{}
+}
+
+// NewRule parses and validates expression. Lists are named sets of
+// strings that can be referenced in the expression.
+func NewRule(name string, expression string, lists map[string][]string) (*Rule, error) {
+	expr, err := parser.ParseExpr(expression)
+	if err != nil {
+		return nil, fmt.Errorf("rule %s: %w", name, err)
+	}
+
+	r := &Rule{
+		Name:  name,
+		expr:  expr,
+		lists: make(map[string]map[string]struct{}, len(lists)),
+	}
+	for listName, values := range lists {
+		set := make(map[string]struct{}, len(values))
+		for _, v := range values {
+			set[strings.ToLower(v)] = struct{}{}
+		}
+		r.lists[strings.ToLower(listName)] = set
+	}
+
+	k, err := r.check(expr)
+	if err != nil {
+		return nil, fmt.Errorf("rule %s: %w", name, err)
+	}
+	if k != kindBool && k != kindAny {
+		return nil, fmt.Errorf("rule %s: expression is %s, not bool", name, k)
+	}
+	return r, nil
+}
+
+// Match evaluates rule against the message
+func (r *Rule) Match(m *message.Message) (bool, error) {
+	v, err := r.eval(r.expr, m)
+	if errors.Is(err, errUndefined) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("rule %s: %w", r.Name, err)
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %s: expression is not bool", r.Name)
+	}
+	return b, nil
+}
+
+// RuleProcessor filters messages matching any of the rules. Message is not relayed
+// if a rule can't be evaluated against it.
+func RuleProcessor(rules []*Rule) message.MessageProcessor {
+	return func(m *message.Message) error {
+		for _, r := range rules {
+			match, err := r.Match(m)
+			if err != nil {
+				return fmt.Errorf("%w: %s", message.ErrMessageFiltered, err)
+			}
+			if match {
+				return fmt.Errorf("%w: matched rule %s", message.ErrMessageFiltered, r.Name)
+			}
+		}
+		return nil
+	}
+}
+
+// check validates expression and returns kind of its value
+func (r *Rule) check(expr ast.Expr) (kind, error) {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return r.check(e.X)
+	case *ast.BasicLit:
+		switch e.Kind {
+		case token.INT, token.FLOAT:
+			_, err := parseNumber(e.Value)
+			return kindNumber, err
+		case token.STRING:
+			_, err := strconv.Unquote(e.Value)
+			return kindString, err
+		}
+	case *ast.Ident:
+		switch e.Name {
+		case "true", "false":
+			return kindBool, nil
+		}
+		f, ok := fields[e.Name]
+		if !ok {
+			return 0, fmt.Errorf("unknown field %s", e.Name)
+		}
+		return f.kind, nil
+	case *ast.IndexExpr:
+		if _, err := metadataKey(e); err != nil {
+			return 0, err
+		}
+		return kindAny, nil
+	case *ast.CallExpr:
+		list, err := r.listArg(e)
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := r.lists[list]; !ok {
+			return 0, fmt.Errorf("unknown list %s", list)
+		}
+		k, err := r.check(e.Args[0])
+		if err != nil {
+			return 0, err
+		}
+		if k != kindString && k != kindAny {
+			return 0, fmt.Errorf("in() requires string value, got %s", k)
+		}
+		return kindBool, nil
+	case *ast.UnaryExpr:
+		if e.Op != token.NOT {
+			return 0, fmt.Errorf("unsupported operator %s", e.Op)
+		}
+		k, err := r.check(e.X)
+		if err != nil {
+			return 0, err
+		}
+		if k != kindBool && k != kindAny {
+			return 0, fmt.Errorf("operator ! requires bool, got %s", k)
+		}
+		return kindBool, nil
+	case *ast.BinaryExpr:
+		x, err := r.check(e.X)
+		if err != nil {
+			return 0, err
+		}
+		y, err := r.check(e.Y)
+		if err != nil {
+			return 0, err
+		}
+		return checkBinary(e.Op, x, y)
+	}
+	return 0, fmt.Errorf("unsupported expression %T", expr)
+}
+
+func checkBinary(op token.Token, x kind, y kind) (kind, error) {
+	compatible := x == y || x == kindAny || y == kindAny
+	switch op {
+	case token.LAND, token.LOR:
+		if (x != kindBool && x != kindAny) || (y != kindBool && y != kindAny) {
+			return 0, fmt.Errorf("operator %s requires bool operands, got %s and %s", op, x, y)
+		}
+	case token.EQL, token.NEQ:
+		if !compatible {
+			return 0, fmt.Errorf("mismatched operands %s and %s of %s", x, y, op)
+		}
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		if !compatible || x == kindBool || x == kindString || y == kindBool || y == kindString {
+			return 0, fmt.Errorf("operator %s requires number operands, got %s and %s", op, x, y)
+		}
+	default:
+		return 0, fmt.Errorf("unsupported operator %s", op)
+	}
+	return kindBool, nil
+}
+
+func (r *Rule) eval(expr ast.Expr, m *message.Message) (interface{}, error) {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return r.eval(e.X, m)
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			return strconv.Unquote(e.Value)
+		}
+		return parseNumber(e.Value)
+	case *ast.Ident:
+		switch e.Name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return fields[e.Name].value(m)
+	case *ast.IndexExpr:
+		key, _ := metadataKey(e)
+		v, ok := m.Metadata.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("metadata %s is %w", key, errUndefined)
+		}
+		return metadataValue(v)
+	case *ast.CallExpr:
+		list, _ := r.listArg(e)
+		v, err := r.eval(e.Args[0], m)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("in() requires string value, got %v", v)
+		}
+		_, ok = r.lists[list][strings.ToLower(s)]
+		return ok, nil
+	case *ast.UnaryExpr:
+		v, err := r.evalBool(e.X, m)
+		if err != nil {
+			return nil, err
+		}
+		return !v, nil
+	case *ast.BinaryExpr:
+		return r.evalBinary(e, m)
+	}
+	return nil, fmt.Errorf("unsupported expression %T", expr)
+}
+
+func (r *Rule) evalBool(expr ast.Expr, m *message.Message) (bool, error) {
+	v, err := r.eval(expr, m)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected bool, got %v", v)
+	}
+	return b, nil
+}
+
+func (r *Rule) evalBinary(e *ast.BinaryExpr, m *message.Message) (interface{}, error) {
+	// logical operators short circuit so rules can guard fields that
+	// aren't defined for every message, e.g. transferType == "FungibleTransfer" && amount > 1
+	switch e.Op {
+	case token.LAND, token.LOR:
+		x, err := r.evalBool(e.X, m)
+		if err != nil {
+			return nil, err
+		}
+		if (e.Op == token.LAND && !x) || (e.Op == token.LOR && x) {
+			return x, nil
+		}
+		return r.evalBool(e.Y, m)
+	}
+
+	x, err := r.eval(e.X, m)
+	if err != nil {
+		return nil, err
+	}
+	y, err := r.eval(e.Y, m)
+	if err != nil {
+		return nil, err
+	}
+	cmp, err := compare(x, y)
+	if err != nil {
+		return nil, err
+	}
+	switch e.Op {
+	case token.EQL:
+		return cmp == 0, nil
+	case token.NEQ:
+		return cmp != 0, nil
+	}
+
+	if _, ok := x.(*big.Int); !ok {
+		return nil, fmt.Errorf("operator %s requires number operands, got %v and %v", e.Op, x, y)
+	}
+	switch e.Op {
+	case token.LSS:
+		return cmp < 0, nil
+	case token.LEQ:
+		return cmp <= 0, nil
+	case token.GTR:
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+func compare(x interface{}, y interface{}) (int, error) {
+	switch xv := x.(type) {
+	case *big.Int:
+		if yv, ok := y.(*big.Int); ok {
+			return xv.Cmp(yv), nil
+		}
+	case string:
+		if yv, ok := y.(string); ok {
+			return strings.Compare(strings.ToLower(xv), strings.ToLower(yv)), nil
+		}
+	case bool:
+		if yv, ok := y.(bool); ok {
+			if xv == yv {
+				return 0, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, fmt.Errorf("mismatched operands %v and %v", x, y)
+}
+
+func (r *Rule) listArg(e *ast.CallExpr) (string, error) {
+	fn, ok := e.Fun.(*ast.Ident)
+	if !ok || fn.Name != "in" {
+		return "", errors.New("only in(value, \"list\") function is supported")
+	}
+	if len(e.Args) != 2 {
+		return "", errors.New("in() requires 2 arguments")
+	}
+	lit, ok := e.Args[1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", errors.New("in() requires list name string literal")
+	}
+	list, err := strconv.Unquote(lit.Value)
+	return strings.ToLower(list), err
+}
+
+func metadataKey(e *ast.IndexExpr) (string, error) {
+	ident, ok := e.X.(*ast.Ident)
+	if !ok || ident.Name != "metadata" {
+		return "", errors.New("only metadata[\"key\"] can be indexed")
+	}
+	lit, ok := e.Index.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", errors.New("metadata key has to be a string literal")
+	}
+	return strconv.Unquote(lit.Value)
+}
+
+func metadataValue(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case string, bool:
+		return value, nil
+	case *big.Int:
+		return value, nil
+	case int:
+		return big.NewInt(int64(value)), nil
+	case int64:
+		return big.NewInt(value), nil
+	case uint8:
+		return big.NewInt(int64(value)), nil
+	case uint64:
+		return new(big.Int).SetUint64(value), nil
+	case []byte:
+		return hexString(value), nil
+	}
+	return nil, fmt.Errorf("unsupported metadata value %v", v)
+}
+
+// parseNumber parses integer literal, including exponent notation like 1e24
+func parseNumber(s string) (*big.Int, error) {
+	if n, ok := new(big.Int).SetString(s, 0); ok {
+		return n, nil
+	}
+	f, _, err := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %s", s)
+	}
+	n, accuracy := f.Int(nil)
+	if accuracy != big.Exact {
+		return nil, fmt.Errorf("number %s is not an integer", s)
+	}
+	return n, nil
+}
+
+func hexString(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
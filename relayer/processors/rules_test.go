@@ -0,0 +1,119 @@
+package processors_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/config/relayer"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/processors"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type RulesTestSuite struct {
+	suite.Suite
+	lists map[string][]string
+}
+
+func TestRunRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(RulesTestSuite))
+}
+
+func (s *RulesTestSuite) SetupTest() {
+	s.lists = map[string][]string{"Trusted": {common.HexToAddress("0x1").Hex()}}
+}
+
+func (s *RulesTestSuite) transfer(amount *big.Int, sender common.Address) *message.Message {
+	return &message.Message{
+		Source:      1,
+		Destination: 3,
+		ResourceId:  types.ResourceID{31: 1},
+		Type:        message.FungibleTransfer,
+		Payload:     []interface{}{amount.Bytes(), common.HexToAddress("0x5").Bytes()},
+		Origin:      message.Origin{Sender: sender},
+		Metadata:    message.Metadata{Data: map[string]interface{}{"tag": "fast", "fee": uint64(7)}},
+	}
+}
+
+func (s *RulesTestSuite) match(expression string, m *message.Message) bool {
+	rule, err := processors.NewRule("rule", expression, s.lists)
+	s.Nil(err)
+	match, err := rule.Match(m)
+	s.Nil(err)
+	return match
+}
+
+func (s *RulesTestSuite) TestLargeTransferFromUntrustedSender() {
+	expression := `resourceId == "0x0000000000000000000000000000000000000000000000000000000000000001" && amount > 1e24 && destination == 3 && !in(sender, "trusted")`
+	large, _ := new(big.Int).SetString("1000000000000000000000001", 10)
+
+	s.True(s.match(expression, s.transfer(large, common.HexToAddress("0x2"))))
+	s.False(s.match(expression, s.transfer(large, common.HexToAddress("0x1"))))
+	s.False(s.match(expression, s.transfer(big.NewInt(1), common.HexToAddress("0x2"))))
+}
+
+func (s *RulesTestSuite) TestMetadata() {
+	m := s.transfer(big.NewInt(1), common.Address{})
+
+	s.True(s.match(`metadata["tag"] == "FAST" && metadata["fee"] >= 7`, m))
+	s.False(s.match(`metadata["fee"] < 7`, m))
+}
+
+func (s *RulesTestSuite) TestShortCircuitGuardsUndefinedFields() {
+	m := &message.Message{Type: message.GenericTransfer}
+
+	s.False(s.match(`transferType == "FungibleTransfer" && amount > 10`, m))
+}
+
+func (s *RulesTestSuite) TestUndefinedFieldDoesNotMatch() {
+	m := &message.Message{Type: message.GenericTransfer}
+
+	s.False(s.match(`amount > 10`, m))
+	s.False(s.match(`!(amount > 10)`, m))
+	s.False(s.match(`metadata["missing"] == "value"`, m))
+}
+
+func (s *RulesTestSuite) TestInvalidRules() {
+	for _, expression := range []string{
+		`amount >`,
+		`unknown == 1`,
+		`amount > "1"`,
+		`sender < "0x1"`,
+		`destination + 1 == 2`,
+		`in(sender, "missing")`,
+		`len(sender) == 1`,
+		`destination`,
+		`amount > 1.5`,
+	} {
+		_, err := processors.NewRule("rule", expression, s.lists)
+		s.NotNil(err, expression)
+	}
+}
+
+func (s *RulesTestSuite) TestRuleProcessorFiltersMatchingMessages() {
+	rule, _ := processors.NewRule("to-3", `destination == 3`, nil)
+	mp := processors.RuleProcessor([]*processors.Rule{rule})
+
+	s.True(errors.Is(mp(s.transfer(big.NewInt(1), common.Address{})), message.ErrMessageFiltered))
+	s.Nil(mp(&message.Message{Destination: 2}))
+}
+
+func (s *RulesTestSuite) TestRuleProcessorFiltersMessagesRuleCantEvaluate() {
+	rule, _ := processors.NewRule("large", `amount > 1e24`, nil)
+	mp := processors.RuleProcessor([]*processors.Rule{rule})
+
+	err := mp(&message.Message{Type: message.FungibleTransfer})
+
+	s.True(errors.Is(err, message.ErrMessageFiltered))
+}
+
+func (s *RulesTestSuite) TestNewProcessorsValidatesRules() {
+	_, err := processors.NewProcessors(relayer.ProcessorsConfig{
+		Rules: []relayer.Rule{{Name: "invalid", Expression: `unknown == 1`}},
+	})
+
+	s.NotNil(err)
+}
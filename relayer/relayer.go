@@ -5,9 +5,13 @@ package relayer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
+	"github.com/ChainSafe/chainbridge-core/util"
 	"github.com/rs/zerolog/log"
 )
 
@@ -19,12 +23,39 @@ type DepositMeter interface {
 
 type RelayedChain interface {
 	PollEvents(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message)
-	Write(messages []*message.Message) error
+	// Write executes messages on the chain and sends outcome of every message
+	// to results channel, unless it returns an error.
+	Write(messages []*message.Message, results chan<- *message.ExecutionResult) error
 	DomainID() uint8
 }
 
-func NewRelayer(chains []RelayedChain, metrics DepositMeter, messageProcessors ...message.MessageProcessor) *Relayer {
-	return &Relayer{relayedChains: chains, messageProcessors: messageProcessors, metrics: metrics}
+// MessageOutbox removes messages that won't be relayed from the source chain outbox
+type MessageOutbox interface {
+	MarkDone(m *message.Message) error
+}
+
+// GracefulChain is implemented by chains that finish work in progress
+// after their PollEvents context is done
+type GracefulChain interface {
+	// Wait blocks until the chain stopped or ctx is done
+	Wait(ctx context.Context) error
+}
+
+// ChainErrorReporter is notified of every chain failure before the chain is
+// restarted or its failure escalated
+type ChainErrorReporter interface {
+	ReportChainError(domainID uint8, err error)
+}
+
+// RetractingChain is implemented by chains that can drop written messages
+// before they are executed
+type RetractingChain interface {
+	Retract(msgs []*message.Message)
+}
+
+// NewRelayer creates a Relayer that restarts failed chains according to restartPolicy
+func NewRelayer(chains []RelayedChain, metrics DepositMeter, restartPolicy *retry.Policy, messageProcessors ...message.MessageProcessor) *Relayer {
+	return &Relayer{relayedChains: chains, messageProcessors: messageProcessors, metrics: metrics, restartPolicy: restartPolicy, messages: make(chan []*message.Message), stopped: make(chan struct{})}
 }
 
 type Relayer struct {
@@ -32,24 +63,43 @@ type Relayer struct {
 	relayedChains     []RelayedChain
 	registry          map[uint8]RelayedChain
 	messageProcessors []message.MessageProcessor
+	outbox            MessageOutbox
+	errorReporters    []ChainErrorReporter
+	messages          chan []*message.Message
+	restartPolicy     *retry.Policy
+	routes            sync.WaitGroup
+	stopped           chan struct{}
+
+	// lock guards chains, which can be added and removed while relayer is running
+	lock   sync.RWMutex
+	cancel map[uint8]context.CancelFunc
+	ctx    context.Context
+	sysErr chan error
 }
 
 // Start function starts the relayer. Relayer routine is starting all the chains
-// and passing them with a channel that accepts unified cross chain message format
+// and passing them with a channel that accepts unified cross chain message format.
+// Failed chains are restarted and only chain that keeps failing is reported to sysErr.
 func (r *Relayer) Start(ctx context.Context, sysErr chan error) {
 	log.Debug().Msgf("Starting relayer")
+	defer close(r.stopped)
 
-	messagesChannel := make(chan []*message.Message)
+	r.lock.Lock()
+	r.ctx = ctx
+	r.sysErr = sysErr
 	for _, c := range r.relayedChains {
-		log.Debug().Msgf("Starting chain %v", c.DomainID())
-		r.addRelayedChain(c)
-		go c.PollEvents(ctx, sysErr, messagesChannel)
+		r.startChain(c)
 	}
+	r.lock.Unlock()
 
 	for {
 		select {
-		case m := <-messagesChannel:
-			go r.route(m)
+		case m := <-r.messages:
+			r.routes.Add(1)
+			go func() {
+				defer r.routes.Done()
+				r.route(m)
+			}()
 			continue
 		case <-ctx.Done():
 			return
@@ -57,27 +107,87 @@ func (r *Relayer) Start(ctx context.Context, sysErr chan error) {
 	}
 }
 
+// Stop waits until relayer started with Start is stopped by cancelling its context,
+// chains finish executions in progress and outcomes of routed messages are tracked.
+// Returns error if ctx is done first.
+func (r *Relayer) Stop(ctx context.Context) error {
+	select {
+	case <-r.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for _, c := range r.chains() {
+		gc, ok := c.(GracefulChain)
+		if !ok {
+			continue
+		}
+		if err := gc.Wait(ctx); err != nil {
+			return fmt.Errorf("chain %v didn't stop: %w", c.DomainID(), err)
+		}
+	}
+	return util.Wait(ctx, &r.routes)
+}
+
+// Submit routes messages that were not read by chain listeners, e.g. retried
+// or injected by an operator. All messages have to share the same destination.
+func (r *Relayer) Submit(ctx context.Context, msgs []*message.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	destination := msgs[0].Destination
+	for _, m := range msgs {
+		if m.Destination != destination {
+			return fmt.Errorf("messages have different destinations")
+		}
+	}
+	if !r.hasRelayedChain(destination) {
+		return fmt.Errorf("no chain registered for destination %v", destination)
+	}
+
+	select {
+	case r.messages <- msgs:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Route function runs destination writer by mapping DestinationID from message to registered writer.
 func (r *Relayer) route(msgs []*message.Message) {
+	r.lock.RLock()
 	destChain, ok := r.registry[msgs[0].Destination]
+	r.lock.RUnlock()
 	if !ok {
 		log.Error().Msgf("no resolver for destID %v to send message registered", msgs[0].Destination)
 		return
 	}
 
+	processed := make([]*message.Message, 0, len(msgs))
 	for _, m := range msgs {
 		r.metrics.TrackDepositMessage(m)
 
-		for _, mp := range r.messageProcessors {
-			if err := mp(m); err != nil {
-				log.Error().Err(fmt.Errorf("error %w processing mesage %v", err, m))
-				return
-			}
+		err := r.process(m)
+		if errors.Is(err, message.ErrMessageFiltered) {
+			r.dropFiltered(m, err)
+			continue
 		}
+		if err != nil {
+			// message stays in the outbox so it is processed again after a restart
+			log.Error().Err(err).Msgf("Failed processing message %v", m)
+			r.metrics.TrackExecutionError(m)
+			continue
+		}
+		processed = append(processed, m)
 	}
+	if len(processed) == 0 {
+		return
+	}
+	msgs = processed
 
 	log.Debug().Msgf("Sending messages %+v to destination %v", msgs, destChain.DomainID())
-	err := destChain.Write(msgs)
+	results := make(chan *message.ExecutionResult, len(msgs))
+	err := destChain.Write(msgs, results)
 	if err != nil {
 		for _, m := range msgs {
 			log.Err(err).Msgf("Failed sending messages %+v to destination %v", m, destChain.DomainID())
@@ -86,9 +196,154 @@ func (r *Relayer) route(msgs []*message.Message) {
 		return
 	}
 
+	for range msgs {
+		result := <-results
+		if errors.Is(result.Err, message.ErrMessageRetracted) {
+			log.Warn().Str("messageID", result.Message.ID()).Msgf("Message retracted before execution on destination %v", destChain.DomainID())
+			continue
+		}
+		if errors.Is(result.Err, message.ErrMessageAlreadyQueued) {
+			log.Debug().Str("messageID", result.Message.ID()).Msgf("Message already queued for execution on destination %v", destChain.DomainID())
+			continue
+		}
+		if result.Err != nil {
+			log.Err(result.Err).Msgf("Failed executing message %+v on destination %v", result.Message, destChain.DomainID())
+			r.metrics.TrackExecutionError(result.Message)
+			continue
+		}
+
+		r.metrics.TrackSuccessfulExecutionLatency(result.Message)
+	}
+}
+
+// Retract drops messages read from reorganized blocks from destination
+// chains, so they aren't executed if they are still waiting in a queue
+func (r *Relayer) Retract(msgs []*message.Message) {
+	destinations := make(map[uint8][]*message.Message)
 	for _, m := range msgs {
-		r.metrics.TrackSuccessfulExecutionLatency(m)
+		destinations[m.Destination] = append(destinations[m.Destination], m)
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	for destination, destMsgs := range destinations {
+		rc, ok := r.registry[destination].(RetractingChain)
+		if !ok {
+			continue
+		}
+		rc.Retract(destMsgs)
+	}
+}
+
+func (r *Relayer) process(m *message.Message) error {
+	for _, mp := range r.messageProcessors {
+		if err := mp(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropFiltered removes message filtered by a processor from the outbox
+// so it isn't redelivered
+func (r *Relayer) dropFiltered(m *message.Message, reason error) {
+	log.Warn().Str("messageID", m.ID()).Msgf("Message not relayed: %s", reason)
+	if r.outbox == nil {
+		return
+	}
+	if err := r.outbox.MarkDone(m); err != nil {
+		log.Error().Err(err).Msgf("Failed removing filtered message %s from outbox", m.ID())
+	}
+}
+
+// RegisterOutbox sets outbox from which messages filtered by message processors are removed
+func (r *Relayer) RegisterOutbox(outbox MessageOutbox) {
+	r.outbox = outbox
+}
+
+// RegisterErrorReporter registers reporter notified of chain failures
+func (r *Relayer) RegisterErrorReporter(reporter ChainErrorReporter) {
+	r.errorReporters = append(r.errorReporters, reporter)
+}
+
+// AddChain adds chain to the relayer and starts it if the relayer is running.
+// Returns error if chain with the same domain ID is already added.
+func (r *Relayer) AddChain(c RelayedChain) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, rc := range r.relayedChains {
+		if rc.DomainID() == c.DomainID() {
+			return fmt.Errorf("chain %v already added", c.DomainID())
+		}
+	}
+
+	r.relayedChains = append(r.relayedChains, c)
+	if r.ctx != nil {
+		r.startChain(c)
+	}
+	log.Info().Msgf("Added chain %v", c.DomainID())
+	return nil
+}
+
+// RemoveChain stops the chain and removes it from the relayer so messages are
+// no longer routed to it. Waits until the chain finishes executions in progress
+// or ctx is done.
+func (r *Relayer) RemoveChain(ctx context.Context, domainID uint8) error {
+	r.lock.Lock()
+	var removed RelayedChain
+	for i, c := range r.relayedChains {
+		if c.DomainID() == domainID {
+			removed = c
+			r.relayedChains = append(r.relayedChains[:i:i], r.relayedChains[i+1:]...)
+			break
+		}
+	}
+	if removed == nil {
+		r.lock.Unlock()
+		return fmt.Errorf("chain %v not found", domainID)
+	}
+	delete(r.registry, domainID)
+	if cancel, ok := r.cancel[domainID]; ok {
+		cancel()
+		delete(r.cancel, domainID)
+	}
+	r.lock.Unlock()
+
+	log.Info().Msgf("Removed chain %v", domainID)
+	if gc, ok := removed.(GracefulChain); ok {
+		return gc.Wait(ctx)
+	}
+	return nil
+}
+
+// startChain registers chain as message destination and starts supervising it
+// until the relayer or the chain is stopped. Requires lock to be held.
+func (r *Relayer) startChain(c RelayedChain) {
+	r.addRelayedChain(c)
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	if r.cancel == nil {
+		r.cancel = make(map[uint8]context.CancelFunc)
+	}
+	r.cancel[c.DomainID()] = cancel
+	go r.supervise(ctx, c, r.sysErr)
+}
+
+func (r *Relayer) chains() []RelayedChain {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return append([]RelayedChain{}, r.relayedChains...)
+}
+
+func (r *Relayer) hasRelayedChain(domainID uint8) bool {
+	for _, c := range r.chains() {
+		if c.DomainID() == domainID {
+			return true
+		}
 	}
+	return false
 }
 
 func (r *Relayer) addRelayedChain(c RelayedChain) {
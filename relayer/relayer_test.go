@@ -1,12 +1,15 @@
 package relayer
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	mock_relayer "github.com/ChainSafe/chainbridge-core/relayer/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -61,10 +64,12 @@ func (s *RouteTestSuite) TestAdjustDecimalsForERC20AmountMessageProcessor() {
 
 func (s *RouteTestSuite) TestLogsErrorIfMessageProcessorReturnsError() {
 	s.mockMetrics.EXPECT().TrackDepositMessage(gomock.Any())
+	s.mockMetrics.EXPECT().TrackExecutionError(&message.Message{Destination: 1})
 	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1))
 	relayer := NewRelayer(
 		[]RelayedChain{},
 		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
 		func(m *message.Message) error { return fmt.Errorf("error") },
 	)
 	relayer.addRelayedChain(s.mockRelayedChain)
@@ -74,14 +79,76 @@ func (s *RouteTestSuite) TestLogsErrorIfMessageProcessorReturnsError() {
 	})
 }
 
+func (s *RouteTestSuite) TestSkipsMessageThatFailedProcessing() {
+	s.mockMetrics.EXPECT().TrackDepositMessage(gomock.Any()).Times(3)
+	s.mockMetrics.EXPECT().TrackExecutionError(&message.Message{Destination: 1, DepositNonce: 2})
+	s.mockMetrics.EXPECT().TrackSuccessfulExecutionLatency(gomock.Any()).Times(2)
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).Times(2)
+	s.mockRelayedChain.EXPECT().Write([]*message.Message{{Destination: 1, DepositNonce: 1}, {Destination: 1, DepositNonce: 3}}, gomock.Any()).DoAndReturn(func(msgs []*message.Message, results chan<- *message.ExecutionResult) error {
+		for _, m := range msgs {
+			results <- &message.ExecutionResult{Message: m}
+		}
+		return nil
+	})
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+		func(m *message.Message) error {
+			if m.DepositNonce == 2 {
+				return fmt.Errorf("error")
+			}
+			return nil
+		},
+	)
+	relayer.addRelayedChain(s.mockRelayedChain)
+
+	relayer.route([]*message.Message{
+		{Destination: 1, DepositNonce: 1},
+		{Destination: 1, DepositNonce: 2},
+		{Destination: 1, DepositNonce: 3},
+	})
+}
+
+func (s *RouteTestSuite) TestDropsFilteredMessages() {
+	mockOutbox := mock_relayer.NewMockMessageOutbox(gomock.NewController(s.T()))
+	s.mockMetrics.EXPECT().TrackDepositMessage(gomock.Any()).Times(2)
+	s.mockMetrics.EXPECT().TrackSuccessfulExecutionLatency(gomock.Any())
+	mockOutbox.EXPECT().MarkDone(&message.Message{Destination: 1, DepositNonce: 1})
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).Times(2)
+	s.mockRelayedChain.EXPECT().Write([]*message.Message{{Destination: 1, DepositNonce: 2}}, gomock.Any()).DoAndReturn(func(msgs []*message.Message, results chan<- *message.ExecutionResult) error {
+		results <- &message.ExecutionResult{Message: msgs[0]}
+		return nil
+	})
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+		func(m *message.Message) error {
+			if m.DepositNonce == 1 {
+				return message.ErrMessageFiltered
+			}
+			return nil
+		},
+	)
+	relayer.RegisterOutbox(mockOutbox)
+	relayer.addRelayedChain(s.mockRelayedChain)
+
+	relayer.route([]*message.Message{
+		{Destination: 1, DepositNonce: 1},
+		{Destination: 1, DepositNonce: 2},
+	})
+}
+
 func (s *RouteTestSuite) TestWriteFail() {
 	s.mockMetrics.EXPECT().TrackDepositMessage(gomock.Any())
 	s.mockMetrics.EXPECT().TrackExecutionError(gomock.Any())
 	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).Times(3)
-	s.mockRelayedChain.EXPECT().Write(gomock.Any()).Return(fmt.Errorf("error"))
+	s.mockRelayedChain.EXPECT().Write(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
 	relayer := NewRelayer(
 		[]RelayedChain{},
 		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
 		func(m *message.Message) error { return nil },
 	)
 	relayer.addRelayedChain(s.mockRelayedChain)
@@ -95,10 +162,14 @@ func (s *RouteTestSuite) TestWritesToDestChainIfMessageValid() {
 	s.mockMetrics.EXPECT().TrackDepositMessage(gomock.Any())
 	s.mockMetrics.EXPECT().TrackSuccessfulExecutionLatency(gomock.Any())
 	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).Times(2)
-	s.mockRelayedChain.EXPECT().Write(gomock.Any())
+	s.mockRelayedChain.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msgs []*message.Message, results chan<- *message.ExecutionResult) error {
+		results <- &message.ExecutionResult{Message: msgs[0]}
+		return nil
+	})
 	relayer := NewRelayer(
 		[]RelayedChain{},
 		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
 		func(m *message.Message) error { return nil },
 	)
 	relayer.addRelayedChain(s.mockRelayedChain)
@@ -107,3 +178,200 @@ func (s *RouteTestSuite) TestWritesToDestChainIfMessageValid() {
 		{Destination: 1},
 	})
 }
+
+func (s *RouteTestSuite) TestTracksFailedExecution() {
+	s.mockMetrics.EXPECT().TrackDepositMessage(gomock.Any()).Times(2)
+	s.mockMetrics.EXPECT().TrackSuccessfulExecutionLatency(&message.Message{Destination: 1, DepositNonce: 1})
+	s.mockMetrics.EXPECT().TrackExecutionError(&message.Message{Destination: 1, DepositNonce: 2})
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).Times(3)
+	s.mockRelayedChain.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msgs []*message.Message, results chan<- *message.ExecutionResult) error {
+		results <- &message.ExecutionResult{Message: msgs[1], Err: fmt.Errorf("error")}
+		results <- &message.ExecutionResult{Message: msgs[0]}
+		return nil
+	})
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	relayer.addRelayedChain(s.mockRelayedChain)
+
+	relayer.route([]*message.Message{
+		{Destination: 1, DepositNonce: 1},
+		{Destination: 1, DepositNonce: 2},
+	})
+}
+
+func (s *RouteTestSuite) TestDoesNotTrackRetractedMessageAsFailed() {
+	s.mockMetrics.EXPECT().TrackDepositMessage(gomock.Any())
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).Times(3)
+	s.mockRelayedChain.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msgs []*message.Message, results chan<- *message.ExecutionResult) error {
+		results <- &message.ExecutionResult{Message: msgs[0], Err: message.ErrMessageRetracted}
+		return nil
+	})
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	relayer.addRelayedChain(s.mockRelayedChain)
+
+	relayer.route([]*message.Message{
+		{Destination: 1, DepositNonce: 1},
+	})
+}
+
+func (s *RouteTestSuite) TestRetractRemovesMessagesFromDestination() {
+	mockRetractingChain := mock_relayer.NewMockRetractingChain(gomock.NewController(s.T()))
+	chain := struct {
+		*mock_relayer.MockRelayedChain
+		*mock_relayer.MockRetractingChain
+	}{s.mockRelayedChain, mockRetractingChain}
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1))
+	mockRetractingChain.EXPECT().Retract([]*message.Message{{Destination: 1, DepositNonce: 1}})
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	relayer.addRelayedChain(chain)
+
+	relayer.Retract([]*message.Message{
+		{Destination: 1, DepositNonce: 1},
+		{Destination: 2, DepositNonce: 1},
+	})
+}
+
+func (s *RouteTestSuite) TestSubmitFailsIfDestinationDoesNotExist() {
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1))
+	relayer := NewRelayer(
+		[]RelayedChain{s.mockRelayedChain},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+
+	err := relayer.Submit(context.Background(), []*message.Message{
+		{Destination: 2},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *RouteTestSuite) TestSubmitSendsMessagesToRouter() {
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1))
+	relayer := NewRelayer(
+		[]RelayedChain{s.mockRelayedChain},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	msgs := []*message.Message{{Destination: 1}}
+
+	go func() {
+		err := relayer.Submit(context.Background(), msgs)
+		s.Nil(err)
+	}()
+
+	s.Equal(msgs, <-relayer.messages)
+}
+
+func (s *RouteTestSuite) TestStopWaitsForRelayerToStop() {
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	err := relayer.Stop(ctx)
+
+	s.Equal(context.DeadlineExceeded, err)
+}
+
+func (s *RouteTestSuite) TestStopReturnsOnceRelayerStopped() {
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	go relayer.Start(ctx, make(chan error))
+	cancel()
+
+	err := relayer.Stop(context.Background())
+
+	s.Nil(err)
+}
+
+func (s *RouteTestSuite) TestAddChainStartsChainIfRelayerRunning() {
+	started := make(chan struct{})
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).AnyTimes()
+	s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+		func(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message) {
+			close(started)
+		})
+	relayer := NewRelayer(
+		[]RelayedChain{},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relayer.Start(ctx, make(chan error))
+	s.Eventually(func() bool {
+		relayer.lock.RLock()
+		defer relayer.lock.RUnlock()
+		return relayer.ctx != nil
+	}, time.Second, time.Millisecond)
+
+	err := relayer.AddChain(s.mockRelayedChain)
+
+	s.Nil(err)
+	<-started
+	s.True(relayer.hasRelayedChain(1))
+}
+
+func (s *RouteTestSuite) TestAddChainFailsIfChainAlreadyAdded() {
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).AnyTimes()
+	relayer := NewRelayer(
+		[]RelayedChain{s.mockRelayedChain},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+
+	err := relayer.AddChain(s.mockRelayedChain)
+
+	s.NotNil(err)
+}
+
+func (s *RouteTestSuite) TestRemoveChainStopsChain() {
+	stopped := make(chan struct{})
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).AnyTimes()
+	s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+		func(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message) {
+			go func() {
+				<-ctx.Done()
+				close(stopped)
+			}()
+		})
+	relayer := NewRelayer(
+		[]RelayedChain{s.mockRelayedChain},
+		s.mockMetrics,
+		retry.NewPolicy(0, time.Millisecond, time.Millisecond),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relayer.Start(ctx, make(chan error))
+	s.Eventually(func() bool {
+		relayer.lock.RLock()
+		defer relayer.lock.RUnlock()
+		return relayer.registry[1] != nil
+	}, time.Second, time.Millisecond)
+
+	err := relayer.RemoveChain(context.Background(), 1)
+
+	s.Nil(err)
+	<-stopped
+	s.False(relayer.hasRelayedChain(1))
+	s.NotNil(relayer.RemoveChain(context.Background(), 1))
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks error as one that can't be fixed by retrying
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent checks if error or any error it wraps was marked as permanent
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// Policy retries failed calls with exponential backoff
+type Policy struct {
+	maxRetries      int
+	initialInterval time.Duration
+	maxInterval     time.Duration
+}
+
+// NewPolicy creates a Policy that retries a call up to maxRetries times. Wait
+// before the first retry is initialInterval and doubles with every next retry
+// up to maxInterval.
+func NewPolicy(maxRetries int, initialInterval time.Duration, maxInterval time.Duration) *Policy {
+	return &Policy{
+		maxRetries:      maxRetries,
+		initialInterval: initialInterval,
+		maxInterval:     maxInterval,
+	}
+}
+
+// Backoff returns wait before retry with provided index, starting with 0
+func (p *Policy) Backoff(retry int) time.Duration {
+	interval := p.initialInterval
+	for i := 0; i < retry; i++ {
+		interval *= 2
+		if interval >= p.maxInterval {
+			return p.maxInterval
+		}
+	}
+	return interval
+}
+
+// Exhausted checks if there are no retries left after attempts calls
+func (p *Policy) Exhausted(attempts int) bool {
+	return attempts > p.maxRetries
+}
+
+// Do calls fn until it succeeds, returns a permanent error or retries are
+// exhausted. Returns number of calls made and the last error, or ctx error
+// if ctx is done before the next retry.
+func (p *Policy) Do(ctx context.Context, fn func() error) (int, error) {
+	attempts := 0
+	for {
+		err := fn()
+		attempts++
+		if err == nil || IsPermanent(err) || p.Exhausted(attempts) {
+			return attempts, err
+		}
+
+		timer := time.NewTimer(p.Backoff(attempts - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryTestSuite struct {
+	suite.Suite
+	policy *retry.Policy
+}
+
+func TestRunRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
+
+func (s *RetryTestSuite) SetupTest() {
+	s.policy = retry.NewPolicy(3, time.Millisecond, time.Millisecond*3)
+}
+
+func (s *RetryTestSuite) TestBackoff_DoublesUpToMaxInterval() {
+	s.Equal(time.Millisecond, s.policy.Backoff(0))
+	s.Equal(time.Millisecond*2, s.policy.Backoff(1))
+	s.Equal(time.Millisecond*3, s.policy.Backoff(2))
+	s.Equal(time.Millisecond*3, s.policy.Backoff(10))
+}
+
+func (s *RetryTestSuite) TestDo_RetriesUntilSuccess() {
+	calls := 0
+	attempts, err := s.policy.Do(context.Background(), func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("error")
+		}
+		return nil
+	})
+
+	s.Nil(err)
+	s.Equal(3, attempts)
+}
+
+func (s *RetryTestSuite) TestDo_StopsAfterMaxRetries() {
+	attempts, err := s.policy.Do(context.Background(), func() error {
+		return errors.New("error")
+	})
+
+	s.NotNil(err)
+	s.Equal(4, attempts)
+}
+
+func (s *RetryTestSuite) TestDo_DoesNotRetryPermanentError() {
+	attempts, err := s.policy.Do(context.Background(), func() error {
+		return fmt.Errorf("wrapped: %w", retry.Permanent(errors.New("error")))
+	})
+
+	s.True(retry.IsPermanent(err))
+	s.Equal(1, attempts)
+}
+
+func (s *RetryTestSuite) TestDo_StopsWhenContextDone() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts, err := s.policy.Do(ctx, func() error {
+		return errors.New("error")
+	})
+
+	s.Equal(context.Canceled, err)
+	s.Equal(1, attempts)
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// chainStopTimeout is how long a failed chain has to stop before it is restarted
+var chainStopTimeout = time.Minute
+
+// supervise polls chain events and restarts polling with backoff when the chain
+// reports an error, so a failing chain doesn't stop relaying on other chains.
+// Error is escalated to sysErr once restarts are exhausted or the failed chain
+// doesn't stop in time. Chain that runs longer than its next restart backoff
+// is considered recovered.
+func (r *Relayer) supervise(ctx context.Context, c RelayedChain, sysErr chan<- error) {
+	failures := 0
+	for {
+		chainCtx, cancel := context.WithCancel(ctx)
+		chainErr := make(chan error, 1)
+		started := time.Now()
+
+		log.Debug().Msgf("Starting chain %v", c.DomainID())
+		go c.PollEvents(chainCtx, chainErr, r.messages)
+
+		var err error
+		select {
+		case <-ctx.Done():
+			cancel()
+			return
+		case err = <-chainErr:
+			cancel()
+			go drainChainErrors(ctx, c.DomainID(), chainErr)
+		}
+		for _, reporter := range r.errorReporters {
+			reporter.ReportChainError(c.DomainID(), err)
+		}
+
+		stopErr := waitChainStopped(ctx, c)
+		if ctx.Err() != nil {
+			return
+		}
+		if stopErr != nil {
+			select {
+			case sysErr <- fmt.Errorf("chain %v failed with %s and didn't stop: %w", c.DomainID(), err, stopErr):
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		if time.Since(started) > r.restartPolicy.Backoff(failures) {
+			failures = 0
+		}
+		failures++
+		if r.restartPolicy.Exhausted(failures) {
+			select {
+			case sysErr <- fmt.Errorf("chain %v failed %d times in a row: %w", c.DomainID(), failures, err):
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		backoff := r.restartPolicy.Backoff(failures - 1)
+		log.Error().Err(err).Msgf("Chain %v failed, restarting in %s", c.DomainID(), backoff)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// waitChainStopped waits until the previous run of the chain finished so it
+// doesn't overlap with the restarted one
+func waitChainStopped(ctx context.Context, c RelayedChain) error {
+	gc, ok := c.(GracefulChain)
+	if !ok {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, chainStopTimeout)
+	defer cancel()
+	return gc.Wait(waitCtx)
+}
+
+// drainChainErrors discards errors sent by a stopped chain so it doesn't block
+func drainChainErrors(ctx context.Context, domainID uint8, errs <-chan error) {
+	for {
+		select {
+		case err := <-errs:
+			log.Warn().Err(err).Msgf("Chain %v reported error after it was stopped", domainID)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	mock_relayer "github.com/ChainSafe/chainbridge-core/relayer/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/retry"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SupervisorTestSuite struct {
+	suite.Suite
+	mockRelayedChain *mock_relayer.MockRelayedChain
+	mockMetrics      *mock_relayer.MockDepositMeter
+}
+
+func TestRunSupervisorTestSuite(t *testing.T) {
+	suite.Run(t, new(SupervisorTestSuite))
+}
+
+func (s *SupervisorTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockRelayedChain = mock_relayer.NewMockRelayedChain(gomockController)
+	s.mockMetrics = mock_relayer.NewMockDepositMeter(gomockController)
+	s.mockRelayedChain.EXPECT().DomainID().Return(uint8(1)).AnyTimes()
+}
+
+type gracefulRelayedChain struct {
+	*mock_relayer.MockRelayedChain
+	*mock_relayer.MockGracefulChain
+}
+
+func failPolling(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message) {
+	sysErr <- errors.New("error")
+}
+
+func (s *SupervisorTestSuite) TestRestartsFailedChain() {
+	restarted := make(chan struct{})
+	gomock.InOrder(
+		s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(failPolling),
+		s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+			func(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message) {
+				close(restarted)
+			}),
+	)
+	relayer := NewRelayer([]RelayedChain{s.mockRelayedChain}, s.mockMetrics, retry.NewPolicy(1, time.Millisecond, time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sysErr := make(chan error, 1)
+
+	go relayer.supervise(ctx, s.mockRelayedChain, sysErr)
+
+	select {
+	case <-restarted:
+	case <-time.After(time.Second):
+		s.Fail("chain not restarted")
+	}
+	s.Len(sysErr, 0)
+}
+
+func (s *SupervisorTestSuite) TestReportsChainErrorBeforeRestart() {
+	reported := make(chan struct{})
+	mockReporter := mock_relayer.NewMockChainErrorReporter(gomock.NewController(s.T()))
+	mockReporter.EXPECT().ReportChainError(uint8(1), gomock.Any()).Do(func(domainID uint8, err error) {
+		close(reported)
+	})
+	s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(failPolling)
+	s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	relayer := NewRelayer([]RelayedChain{s.mockRelayedChain}, s.mockMetrics, retry.NewPolicy(1, time.Millisecond, time.Millisecond))
+	relayer.RegisterErrorReporter(mockReporter)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go relayer.supervise(ctx, s.mockRelayedChain, make(chan error, 1))
+
+	select {
+	case <-reported:
+	case <-time.After(time.Second):
+		s.Fail("chain error not reported")
+	}
+}
+
+func (s *SupervisorTestSuite) TestEscalatesAfterConsecutiveFailures() {
+	s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(failPolling).Times(3)
+	relayer := NewRelayer([]RelayedChain{s.mockRelayedChain}, s.mockMetrics, retry.NewPolicy(2, time.Millisecond*10, time.Millisecond*10))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sysErr := make(chan error)
+
+	go relayer.supervise(ctx, s.mockRelayedChain, sysErr)
+
+	select {
+	case err := <-sysErr:
+		s.NotNil(err)
+	case <-time.After(time.Second):
+		s.Fail("error not escalated")
+	}
+}
+
+func (s *SupervisorTestSuite) TestStopsChainWhenContextDone() {
+	stopped := make(chan struct{})
+	s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+		func(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message) {
+			go func() {
+				<-ctx.Done()
+				close(stopped)
+			}()
+		})
+	relayer := NewRelayer([]RelayedChain{s.mockRelayedChain}, s.mockMetrics, retry.NewPolicy(1, time.Millisecond, time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go relayer.supervise(ctx, s.mockRelayedChain, make(chan error))
+	cancel()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		s.Fail("chain not stopped")
+	}
+}
+
+func (s *SupervisorTestSuite) TestWaitsForChainToStopBeforeRestart() {
+	mockGracefulChain := mock_relayer.NewMockGracefulChain(gomock.NewController(s.T()))
+	chain := gracefulRelayedChain{s.mockRelayedChain, mockGracefulChain}
+	stopped := false
+	restarted := make(chan bool)
+	gomock.InOrder(
+		s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(failPolling),
+		mockGracefulChain.EXPECT().Wait(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			stopped = true
+			return nil
+		}),
+		s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+			func(ctx context.Context, sysErr chan<- error, msgChan chan []*message.Message) {
+				restarted <- stopped
+			}),
+	)
+	relayer := NewRelayer([]RelayedChain{chain}, s.mockMetrics, retry.NewPolicy(1, time.Millisecond, time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go relayer.supervise(ctx, chain, make(chan error, 1))
+
+	select {
+	case wasStopped := <-restarted:
+		s.True(wasStopped)
+	case <-time.After(time.Second):
+		s.Fail("chain not restarted")
+	}
+}
+
+func (s *SupervisorTestSuite) TestEscalatesIfChainDoesNotStop() {
+	chainStopTimeout = time.Millisecond * 10
+	defer func() { chainStopTimeout = time.Minute }()
+	mockGracefulChain := mock_relayer.NewMockGracefulChain(gomock.NewController(s.T()))
+	chain := gracefulRelayedChain{s.mockRelayedChain, mockGracefulChain}
+	s.mockRelayedChain.EXPECT().PollEvents(gomock.Any(), gomock.Any(), gomock.Any()).Do(failPolling)
+	mockGracefulChain.EXPECT().Wait(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	relayer := NewRelayer([]RelayedChain{chain}, s.mockMetrics, retry.NewPolicy(1, time.Millisecond, time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sysErr := make(chan error)
+
+	go relayer.supervise(ctx, chain, sysErr)
+
+	select {
+	case err := <-sysErr:
+		s.ErrorIs(err, context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		s.Fail("error not escalated")
+	}
+}
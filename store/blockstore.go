@@ -9,9 +9,16 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/syndtr/goleveldb/leveldb"
 )
 
+// BlockHash is a block number with hash of the block at the time it was processed
+type BlockHash struct {
+	Number *big.Int
+	Hash   common.Hash
+}
+
 type BlockStore struct {
 	db KeyValueReaderWriter
 }
@@ -54,6 +61,53 @@ func (bs *BlockStore) GetLastStoredBlock(domainID uint8) (*big.Int, error) {
 	return block, nil
 }
 
+// StoreBlockHashes stores hashes of the latest processed blocks per domainID
+func (bs *BlockStore) StoreBlockHashes(domainID uint8, hashes []BlockHash) error {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:blockhashes", domainID)
+	key.WriteString(keyS)
+
+	value := bytes.Buffer{}
+	for _, h := range hashes {
+		value.Write(common.LeftPadBytes(h.Number.Bytes(), 32))
+		value.Write(h.Hash.Bytes())
+	}
+
+	err := bs.db.SetByKey(key.Bytes(), value.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetBlockHashes queries the blockstore and returns hashes of the latest processed blocks
+func (bs *BlockStore) GetBlockHashes(domainID uint8) ([]BlockHash, error) {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:blockhashes", domainID)
+	key.WriteString(keyS)
+
+	v, err := bs.db.GetByKey(key.Bytes())
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return []BlockHash{}, nil
+		}
+		return nil, err
+	}
+	if len(v)%64 != 0 {
+		return nil, fmt.Errorf("invalid block hashes length %d", len(v))
+	}
+
+	hashes := make([]BlockHash, len(v)/64)
+	for i := range hashes {
+		hashes[i] = BlockHash{
+			Number: big.NewInt(0).SetBytes(v[i*64 : i*64+32]),
+			Hash:   common.BytesToHash(v[i*64+32 : i*64+64]),
+		}
+	}
+	return hashes, nil
+}
+
 // GetStartBlock queries the blockstore for the latest known block. If the latest block is
 // greater than configured startBlock, then startBlock is replaced with the latest known block.
 func (bs *BlockStore) GetStartBlock(domainID uint8, startBlock *big.Int, latest bool, fresh bool) (*big.Int, error) {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ChainSafe/chainbridge-core/store"
 	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -120,3 +121,47 @@ func (s *BlockStoreTestSuite) TestGetStartBlock_StartBlockLtLastStoredBlock() {
 	s.Nil(err)
 	s.Equal(block, big.NewInt(5))
 }
+
+func (s *BlockStoreTestSuite) TestStoreBlockHashes_SuccessfulStore() {
+	key := "chain:5:blockhashes"
+	value := append(common.LeftPadBytes([]byte{1}, 32), common.Hash{2}.Bytes()...)
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), value).Return(nil)
+
+	err := s.blockStore.StoreBlockHashes(5, []store.BlockHash{{Number: big.NewInt(1), Hash: common.Hash{2}}})
+
+	s.Nil(err)
+}
+
+func (s *BlockStoreTestSuite) TestGetBlockHashes_NotFound() {
+	key := "chain:5:blockhashes"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, leveldb.ErrNotFound)
+
+	hashes, err := s.blockStore.GetBlockHashes(5)
+
+	s.Nil(err)
+	s.Equal(hashes, []store.BlockHash{})
+}
+
+func (s *BlockStoreTestSuite) TestGetBlockHashes_InvalidLength() {
+	key := "chain:5:blockhashes"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return([]byte{1, 2}, nil)
+
+	_, err := s.blockStore.GetBlockHashes(5)
+
+	s.NotNil(err)
+}
+
+func (s *BlockStoreTestSuite) TestGetBlockHashes_SuccessfulFetch() {
+	key := "chain:5:blockhashes"
+	value := append(common.LeftPadBytes([]byte{1}, 32), common.Hash{2}.Bytes()...)
+	value = append(value, append(common.LeftPadBytes([]byte{6}, 32), common.Hash{7}.Bytes()...)...)
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(value, nil)
+
+	hashes, err := s.blockStore.GetBlockHashes(5)
+
+	s.Nil(err)
+	s.Equal(hashes, []store.BlockHash{
+		{Number: big.NewInt(1), Hash: common.Hash{2}},
+		{Number: big.NewInt(6), Hash: common.Hash{7}},
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+)
+
+// DeadLetter is a message whose execution failed permanently
+// or exhausted all retries
+type DeadLetter struct {
+	Message  *message.Message `json:"message"`
+	Error    string           `json:"error"`
+	Attempts int              `json:"attempts"`
+	FailedAt time.Time        `json:"failedAt"`
+}
+
+// DeadLetterStore keeps failed messages per destination domain until an operator
+// inspects them and re-queues or discards them
+type DeadLetterStore struct {
+	db KeyValueStore
+}
+
+func NewDeadLetterStore(db KeyValueStore) *DeadLetterStore {
+	return &DeadLetterStore{
+		db: db,
+	}
+}
+
+// StoreDeadLetter persists failed message with the last execution error
+func (s *DeadLetterStore) StoreDeadLetter(m *message.Message, err error, attempts int) error {
+	value, encodeErr := json.Marshal(&DeadLetter{
+		Message:  m,
+		Error:    err.Error(),
+		Attempts: attempts,
+		FailedAt: time.Now(),
+	})
+	if encodeErr != nil {
+		return encodeErr
+	}
+
+	return s.db.SetByKey(deadLetterKey(m.Destination, m.Source, m.DepositNonce), value)
+}
+
+// DeadLetters returns failed messages to destination domain ordered by source and deposit nonce
+func (s *DeadLetterStore) DeadLetters(destination uint8) ([]*DeadLetter, error) {
+	values, err := s.db.GetByPrefix(deadLetterPrefix(destination))
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetters := make([]*DeadLetter, len(values))
+	for i, v := range values {
+		var dl DeadLetter
+		err := json.Unmarshal(v, &dl)
+		if err != nil {
+			return nil, err
+		}
+		deadLetters[i] = &dl
+	}
+
+	sort.Slice(deadLetters, func(i, j int) bool {
+		if deadLetters[i].Message.Source != deadLetters[j].Message.Source {
+			return deadLetters[i].Message.Source < deadLetters[j].Message.Source
+		}
+		return deadLetters[i].Message.DepositNonce < deadLetters[j].Message.DepositNonce
+	})
+	return deadLetters, nil
+}
+
+// DeleteDeadLetter removes message that was re-queued or discarded
+func (s *DeadLetterStore) DeleteDeadLetter(m *message.Message) error {
+	return s.db.DeleteByKey(deadLetterKey(m.Destination, m.Source, m.DepositNonce))
+}
+
+func deadLetterPrefix(destination uint8) []byte {
+	return []byte(fmt.Sprintf("chain:%d:deadletter:", destination))
+}
+
+func deadLetterKey(destination uint8, source uint8, depositNonce uint64) []byte {
+	key := bytes.Buffer{}
+	key.Write(deadLetterPrefix(destination))
+	key.WriteString(fmt.Sprintf("%d:%d", source, depositNonce))
+	return key.Bytes()
+}
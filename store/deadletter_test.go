@@ -0,0 +1,84 @@
+package store_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeadLetterStoreTestSuite struct {
+	suite.Suite
+	deadLetterStore *store.DeadLetterStore
+	keyValueStore   *mock_store.MockKeyValueStore
+}
+
+func TestRunDeadLetterStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeadLetterStoreTestSuite))
+}
+
+func (s *DeadLetterStoreTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.keyValueStore = mock_store.NewMockKeyValueStore(gomockController)
+	s.deadLetterStore = store.NewDeadLetterStore(s.keyValueStore)
+}
+
+func encodeDeadLetter(dl *store.DeadLetter) []byte {
+	value, _ := json.Marshal(dl)
+	return value
+}
+
+func (s *DeadLetterStoreTestSuite) TestStoreDeadLetter_StoresLastError() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	s.keyValueStore.EXPECT().SetByKey([]byte("chain:2:deadletter:1:3"), gomock.Any()).DoAndReturn(func(key []byte, value []byte) error {
+		var dl store.DeadLetter
+		err := json.Unmarshal(value, &dl)
+		s.Nil(err)
+		s.Equal(m, dl.Message)
+		s.Equal("error", dl.Error)
+		s.Equal(5, dl.Attempts)
+		return nil
+	})
+
+	err := s.deadLetterStore.StoreDeadLetter(m, errors.New("error"), 5)
+
+	s.Nil(err)
+}
+
+func (s *DeadLetterStoreTestSuite) TestDeadLetters_FailedFetch() {
+	s.keyValueStore.EXPECT().GetByPrefix([]byte("chain:2:deadletter:")).Return(nil, errors.New("error"))
+
+	_, err := s.deadLetterStore.DeadLetters(2)
+
+	s.NotNil(err)
+}
+
+func (s *DeadLetterStoreTestSuite) TestDeadLetters_SortedBySourceAndNonce() {
+	dl1 := &store.DeadLetter{Message: &message.Message{Source: 3, Destination: 2, DepositNonce: 1}, Error: "error"}
+	dl2 := &store.DeadLetter{Message: &message.Message{Source: 1, Destination: 2, DepositNonce: 9}, Error: "error"}
+	dl3 := &store.DeadLetter{Message: &message.Message{Source: 1, Destination: 2, DepositNonce: 4}, Error: "error"}
+	s.keyValueStore.EXPECT().GetByPrefix([]byte("chain:2:deadletter:")).Return([][]byte{
+		encodeDeadLetter(dl1), encodeDeadLetter(dl2), encodeDeadLetter(dl3),
+	}, nil)
+
+	deadLetters, err := s.deadLetterStore.DeadLetters(2)
+
+	s.Nil(err)
+	s.Equal(3, len(deadLetters))
+	s.Equal(dl3.Message, deadLetters[0].Message)
+	s.Equal(dl2.Message, deadLetters[1].Message)
+	s.Equal(dl1.Message, deadLetters[2].Message)
+}
+
+func (s *DeadLetterStoreTestSuite) TestDeleteDeadLetter() {
+	s.keyValueStore.EXPECT().DeleteByKey([]byte("chain:2:deadletter:1:3")).Return(nil)
+
+	err := s.deadLetterStore.DeleteDeadLetter(&message.Message{Source: 1, Destination: 2, DepositNonce: 3})
+
+	s.Nil(err)
+}
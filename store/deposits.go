@@ -0,0 +1,385 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog/log"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type DepositStatus string
+
+const (
+	// DepositStatusUnknown is status of deposit whose proposal was seen on
+	// the destination before the deposit was read from the source
+	DepositStatusUnknown   DepositStatus = "unknown"
+	DepositStatusDeposited DepositStatus = "deposited"
+	DepositStatusVoted     DepositStatus = "voted"
+	DepositStatusPassed    DepositStatus = "passed"
+	DepositStatusExecuted  DepositStatus = "executed"
+	DepositStatusCancelled DepositStatus = "cancelled"
+	// DepositStatusRetracted is status of deposit read from blocks that
+	// were removed from the source chain by a reorganization
+	DepositStatusRetracted DepositStatus = "retracted"
+)
+
+// statusOrder is used to ignore status updates that arrive out of order
+var statusOrder = map[DepositStatus]int{
+	DepositStatusUnknown:   0,
+	DepositStatusRetracted: 0,
+	DepositStatusDeposited: 1,
+	DepositStatusVoted:     2,
+	DepositStatusPassed:    3,
+	DepositStatusExecuted:  4,
+	DepositStatusCancelled: 4,
+}
+
+// Deposit is a deposit read from the source chain with the lifecycle
+// of its proposal on the destination chain
+type Deposit struct {
+	Source       uint8                `json:"source"`
+	Destination  uint8                `json:"destination"`
+	DepositNonce uint64               `json:"depositNonce"`
+	ResourceID   types.ResourceID     `json:"resourceId"`
+	Type         message.TransferType `json:"type"`
+	TxHash       common.Hash          `json:"txHash"`
+	BlockNumber  uint64               `json:"blockNumber"`
+	Sender       common.Address       `json:"sender"`
+	Recipient    []byte               `json:"recipient,omitempty"`
+	Amount       *big.Int             `json:"amount,omitempty"`
+	TokenID      *big.Int             `json:"tokenId,omitempty"`
+
+	Status     DepositStatus `json:"status"`
+	VoteTxHash *common.Hash  `json:"voteTxHash,omitempty"`
+	UpdatedAt  time.Time     `json:"updatedAt"`
+}
+
+// RouteStats are deposit counts by status and fungible amounts by
+// hex encoded resource ID of deposits from source to destination domain
+type RouteStats struct {
+	Total    int                   `json:"total"`
+	Statuses map[DepositStatus]int `json:"statuses"`
+	Amounts  map[string]*big.Int   `json:"amounts"`
+}
+
+func newRouteStats() *RouteStats {
+	return &RouteStats{
+		Statuses: make(map[DepositStatus]int),
+		Amounts:  make(map[string]*big.Int),
+	}
+}
+
+// add counts deposit count times, negative count removes a counted deposit
+func (rs *RouteStats) add(d *Deposit, count int) {
+	rs.Total += count
+	rs.Statuses[d.Status] += count
+	if rs.Statuses[d.Status] == 0 {
+		delete(rs.Statuses, d.Status)
+	}
+	if d.Amount == nil {
+		return
+	}
+	resourceID := hexutil.Encode(d.ResourceID[:])
+	amount, ok := rs.Amounts[resourceID]
+	if !ok {
+		amount = big.NewInt(0)
+		rs.Amounts[resourceID] = amount
+	}
+	amount.Add(amount, new(big.Int).Mul(d.Amount, big.NewInt(int64(count))))
+}
+
+// DepositStore indexes deposits by source domain and deposit nonce and tracks
+// their execution on the destination domain
+type DepositStore struct {
+	db KeyValueStore
+	// lock serializes updates of the same deposit coming from
+	// listeners and voters of different chains
+	lock sync.Mutex
+}
+
+func NewDepositStore(db KeyValueStore) *DepositStore {
+	return &DepositStore{
+		db: db,
+	}
+}
+
+// TrackDeposit records deposit read from the source chain. Lifecycle
+// of an already tracked deposit is preserved.
+func (s *DepositStore) TrackDeposit(m *message.Message) {
+	err := s.update(m.Source, m.Destination, m.DepositNonce, func(d *Deposit) {
+		if d.Status == DepositStatusRetracted {
+			d.Status = DepositStatusUnknown
+		}
+		d.ResourceID = m.ResourceId
+		d.Type = m.Type
+		d.TxHash = m.Origin.TxHash
+		d.BlockNumber = m.Origin.BlockNumber
+		d.Sender = m.Origin.Sender
+		switch m.Type {
+		case message.FungibleTransfer:
+			if p, err := m.FungiblePayload(); err == nil {
+				d.Amount = p.Amount
+				d.Recipient = p.Recipient
+			}
+		case message.NonFungibleTransfer:
+			if p, err := m.NonFungiblePayload(); err == nil {
+				d.TokenID = p.TokenID
+				d.Recipient = p.Recipient
+			}
+		}
+		advance(d, DepositStatusDeposited)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed tracking deposit %s", m.ID())
+	}
+}
+
+// Retract marks deposits read from reorganized blocks as retracted,
+// unless their proposal already reached a final status
+func (s *DepositStore) Retract(msgs []*message.Message) {
+	for _, m := range msgs {
+		err := s.update(m.Source, m.Destination, m.DepositNonce, func(d *Deposit) {
+			if d.Status != DepositStatusExecuted && d.Status != DepositStatusCancelled {
+				d.Status = DepositStatusRetracted
+			}
+		})
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed retracting deposit %s", m.ID())
+		}
+	}
+}
+
+// TrackVote records vote sent by the relayer
+func (s *DepositStore) TrackVote(source uint8, destination uint8, depositNonce uint64, txHash common.Hash) {
+	err := s.update(source, destination, depositNonce, func(d *Deposit) {
+		d.VoteTxHash = &txHash
+		advance(d, DepositStatusVoted)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed tracking vote for deposit %d-%d", source, depositNonce)
+	}
+}
+
+// TrackProposalStatus records proposal status change on the destination chain
+func (s *DepositStore) TrackProposalStatus(source uint8, destination uint8, depositNonce uint64, status uint8) {
+	var depositStatus DepositStatus
+	switch status {
+	case message.ProposalStatusPassed:
+		depositStatus = DepositStatusPassed
+	case message.ProposalStatusExecuted:
+		depositStatus = DepositStatusExecuted
+	case message.ProposalStatusCanceled:
+		depositStatus = DepositStatusCancelled
+	default:
+		return
+	}
+
+	err := s.update(source, destination, depositNonce, func(d *Deposit) {
+		advance(d, depositStatus)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed tracking proposal status of deposit %d-%d", source, depositNonce)
+	}
+}
+
+// GetDeposit returns tracked deposit or ErrNotFound
+func (s *DepositStore) GetDeposit(source uint8, destination uint8, depositNonce uint64) (*Deposit, error) {
+	v, err := s.db.GetByKey(depositKey(source, destination, depositNonce))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var d Deposit
+	err = json.Unmarshal(v, &d)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// DepositsByNonce returns deposits from source domain with deposit nonce to
+// all destination domains, as deposit nonces are counted per destination
+func (s *DepositStore) DepositsByNonce(source uint8, depositNonce uint64) ([]*Deposit, error) {
+	deposits := make([]*Deposit, 0)
+	for destination := 0; destination <= math.MaxUint8; destination++ {
+		d, err := s.GetDeposit(source, uint8(destination), depositNonce)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		deposits = append(deposits, d)
+	}
+	return deposits, nil
+}
+
+// Deposits returns up to limit deposits from source to destination domain with
+// deposit nonce greater than after, ordered by deposit nonce
+func (s *DepositStore) Deposits(source uint8, destination uint8, after uint64, limit int) ([]*Deposit, error) {
+	if after == math.MaxUint64 {
+		return []*Deposit{}, nil
+	}
+	values, err := s.db.GetByPrefixFrom(routePrefix(source, destination), depositKey(source, destination, after+1), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]*Deposit, len(values))
+	for i, v := range values {
+		var d Deposit
+		err := json.Unmarshal(v, &d)
+		if err != nil {
+			return nil, err
+		}
+		deposits[i] = &d
+	}
+	return deposits, nil
+}
+
+// RouteStats returns stats of deposits from source to destination domain
+func (s *DepositStore) RouteStats(source uint8, destination uint8) (*RouteStats, error) {
+	v, err := s.db.GetByKey(routeStatsKey(source, destination))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return newRouteStats(), nil
+		}
+		return nil, err
+	}
+
+	stats := newRouteStats()
+	err = json.Unmarshal(v, stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// DepositsByTxHash returns deposits made in the source chain transaction
+func (s *DepositStore) DepositsByTxHash(txHash common.Hash) ([]*Deposit, error) {
+	keys, err := s.db.GetByPrefix(depositTxPrefix(txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]*Deposit, 0, len(keys))
+	for _, k := range keys {
+		var source, destination uint8
+		var depositNonce uint64
+		_, err := fmt.Sscanf(string(k), "%d:%d:%d", &source, &destination, &depositNonce)
+		if err != nil {
+			return nil, err
+		}
+
+		d, err := s.GetDeposit(source, destination, depositNonce)
+		if err != nil {
+			return nil, err
+		}
+		deposits = append(deposits, d)
+	}
+	return deposits, nil
+}
+
+func (s *DepositStore) update(source uint8, destination uint8, depositNonce uint64, fn func(d *Deposit)) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats, err := s.RouteStats(source, destination)
+	if err != nil {
+		return err
+	}
+	d, err := s.GetDeposit(source, destination, depositNonce)
+	if errors.Is(err, ErrNotFound) {
+		d = &Deposit{Source: source, Destination: destination, DepositNonce: depositNonce, Status: DepositStatusUnknown}
+	} else if err != nil {
+		return err
+	} else {
+		stats.add(d, -1)
+	}
+
+	oldTxHash := d.TxHash
+	fn(d)
+	d.UpdatedAt = time.Now()
+	stats.add(d, 1)
+
+	// deposit, its stats and tx hash index are written together so they
+	// can't get out of sync
+	batch := new(leveldb.Batch)
+	value, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	batch.Put(depositKey(source, destination, depositNonce), value)
+	value, err = json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	batch.Put(routeStatsKey(source, destination), value)
+	if oldTxHash != (common.Hash{}) && oldTxHash != d.TxHash {
+		// deposit was included in a different transaction after a reorganization
+		batch.Delete(depositTxKey(oldTxHash, source, destination, depositNonce))
+	}
+	if d.TxHash != (common.Hash{}) {
+		batch.Put(depositTxKey(d.TxHash, source, destination, depositNonce), []byte(fmt.Sprintf("%d:%d:%d", source, destination, depositNonce)))
+	}
+	return s.db.WriteBatch(batch)
+}
+
+// advance sets deposit status unless deposit already reached a later one
+func advance(d *Deposit, status DepositStatus) {
+	if statusOrder[status] > statusOrder[d.Status] {
+		d.Status = status
+	}
+}
+
+func depositPrefix(source uint8) []byte {
+	return []byte(fmt.Sprintf("chain:%d:deposit:", source))
+}
+
+func routePrefix(source uint8, destination uint8) []byte {
+	key := bytes.Buffer{}
+	key.Write(depositPrefix(source))
+	key.WriteString(fmt.Sprintf("%d:", destination))
+	return key.Bytes()
+}
+
+// depositKey pads deposit nonce so deposits of a route are ordered by nonce
+func depositKey(source uint8, destination uint8, depositNonce uint64) []byte {
+	key := bytes.Buffer{}
+	key.Write(routePrefix(source, destination))
+	key.WriteString(fmt.Sprintf("%020d", depositNonce))
+	return key.Bytes()
+}
+
+func routeStatsKey(source uint8, destination uint8) []byte {
+	return []byte(fmt.Sprintf("chain:%d:routestats:%d", source, destination))
+}
+
+func depositTxPrefix(txHash common.Hash) []byte {
+	return []byte(fmt.Sprintf("deposittx:%s:", strings.ToLower(txHash.Hex())))
+}
+
+func depositTxKey(txHash common.Hash, source uint8, destination uint8, depositNonce uint64) []byte {
+	key := bytes.Buffer{}
+	key.Write(depositTxPrefix(txHash))
+	key.WriteString(fmt.Sprintf("%d:%d:%d", source, destination, depositNonce))
+	return key.Bytes()
+}
@@ -0,0 +1,192 @@
+package store_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type DepositStoreTestSuite struct {
+	suite.Suite
+	db           *lvldb.LVLDB
+	depositStore *store.DepositStore
+	deposit      *message.Message
+}
+
+func TestRunDepositStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DepositStoreTestSuite))
+}
+
+func (s *DepositStoreTestSuite) SetupTest() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	s.depositStore = store.NewDepositStore(db)
+	s.deposit = message.NewMessage(1, 2, 3, types.ResourceID{1}, message.FungibleTransfer, message.FungiblePayload{
+		Amount:    big.NewInt(100),
+		Recipient: common.HexToAddress("0x1").Bytes(),
+	}.Payload(), message.Metadata{})
+	s.deposit.Origin = message.Origin{TxHash: common.HexToHash("0x2"), BlockNumber: 10, Sender: common.HexToAddress("0x3")}
+}
+
+func (s *DepositStoreTestSuite) TearDownTest() {
+	_ = s.db.Close()
+}
+
+func (s *DepositStoreTestSuite) TestTrackDeposit_WritesDepositStatsAndIndexInOneBatch() {
+	db := mock_store.NewMockKeyValueStore(gomock.NewController(s.T()))
+	depositStore := store.NewDepositStore(db)
+	db.EXPECT().GetByKey(gomock.Any()).Return(nil, leveldb.ErrNotFound).Times(2)
+	db.EXPECT().WriteBatch(gomock.Any()).DoAndReturn(func(batch *leveldb.Batch) error {
+		s.Equal(3, batch.Len())
+		return errors.New("error")
+	})
+
+	depositStore.TrackDeposit(s.deposit)
+}
+
+func (s *DepositStoreTestSuite) TestGetDeposit_NotFound() {
+	_, err := s.depositStore.GetDeposit(1, 2, 3)
+
+	s.Equal(store.ErrNotFound, err)
+}
+
+func (s *DepositStoreTestSuite) TestTrackDeposit() {
+	s.depositStore.TrackDeposit(s.deposit)
+
+	d, err := s.depositStore.GetDeposit(1, 2, 3)
+	s.Nil(err)
+	s.Equal(store.DepositStatusDeposited, d.Status)
+	s.Equal(common.HexToHash("0x2"), d.TxHash)
+	s.Equal(uint64(10), d.BlockNumber)
+	s.Equal(common.HexToAddress("0x3"), d.Sender)
+	s.Equal(types.ResourceID{1}, d.ResourceID)
+	s.Equal(big.NewInt(100), d.Amount)
+	s.Equal(common.HexToAddress("0x1").Bytes(), d.Recipient)
+}
+
+func (s *DepositStoreTestSuite) TestTracksLifecycle() {
+	s.depositStore.TrackDeposit(s.deposit)
+	s.depositStore.TrackVote(1, 2, 3, common.HexToHash("0x4"))
+	s.depositStore.TrackProposalStatus(1, 2, 3, message.ProposalStatusPassed)
+	s.depositStore.TrackProposalStatus(1, 2, 3, message.ProposalStatusExecuted)
+
+	d, err := s.depositStore.GetDeposit(1, 2, 3)
+	s.Nil(err)
+	s.Equal(store.DepositStatusExecuted, d.Status)
+	s.Equal(common.HexToHash("0x4"), *d.VoteTxHash)
+}
+
+func (s *DepositStoreTestSuite) TestIgnoresStatusOutOfOrder() {
+	s.depositStore.TrackProposalStatus(1, 2, 3, message.ProposalStatusExecuted)
+	s.depositStore.TrackDeposit(s.deposit)
+	s.depositStore.TrackVote(1, 2, 3, common.HexToHash("0x4"))
+
+	d, err := s.depositStore.GetDeposit(1, 2, 3)
+	s.Nil(err)
+	s.Equal(store.DepositStatusExecuted, d.Status)
+	s.Equal(common.HexToHash("0x2"), d.TxHash)
+	s.Equal(common.HexToHash("0x4"), *d.VoteTxHash)
+}
+
+func (s *DepositStoreTestSuite) TestDeposits_PagesRouteByNonce() {
+	s.depositStore.TrackDeposit(s.deposit)
+	s.depositStore.TrackProposalStatus(1, 2, 10, message.ProposalStatusPassed)
+	s.depositStore.TrackProposalStatus(1, 2, 1, message.ProposalStatusPassed)
+	s.depositStore.TrackProposalStatus(1, 3, 2, message.ProposalStatusPassed)
+	s.depositStore.TrackProposalStatus(2, 2, 2, message.ProposalStatusPassed)
+
+	deposits, err := s.depositStore.Deposits(1, 2, 0, 2)
+	s.Nil(err)
+	s.Len(deposits, 2)
+	s.Equal(uint64(1), deposits[0].DepositNonce)
+	s.Equal(uint64(3), deposits[1].DepositNonce)
+
+	deposits, err = s.depositStore.Deposits(1, 2, 3, 2)
+	s.Nil(err)
+	s.Len(deposits, 1)
+	s.Equal(uint64(10), deposits[0].DepositNonce)
+}
+
+func (s *DepositStoreTestSuite) TestDepositsByNonce_ReturnsAllRoutes() {
+	s.depositStore.TrackDeposit(s.deposit)
+	s.depositStore.TrackProposalStatus(1, 4, 3, message.ProposalStatusPassed)
+	s.depositStore.TrackProposalStatus(1, 5, 4, message.ProposalStatusPassed)
+	s.depositStore.TrackProposalStatus(2, 2, 3, message.ProposalStatusPassed)
+
+	deposits, err := s.depositStore.DepositsByNonce(1, 3)
+	s.Nil(err)
+	s.Len(deposits, 2)
+	s.Equal(uint8(2), deposits[0].Destination)
+	s.Equal(uint8(4), deposits[1].Destination)
+}
+
+func (s *DepositStoreTestSuite) TestRouteStats() {
+	s.depositStore.TrackDeposit(s.deposit)
+	second := message.NewMessage(1, 2, 4, types.ResourceID{1}, message.FungibleTransfer, message.FungiblePayload{
+		Amount:    big.NewInt(50),
+		Recipient: common.HexToAddress("0x1").Bytes(),
+	}.Payload(), message.Metadata{})
+	s.depositStore.TrackDeposit(second)
+	s.depositStore.TrackProposalStatus(1, 2, 3, message.ProposalStatusExecuted)
+	s.depositStore.TrackProposalStatus(1, 3, 1, message.ProposalStatusPassed)
+
+	stats, err := s.depositStore.RouteStats(1, 2)
+
+	s.Nil(err)
+	s.Equal(2, stats.Total)
+	s.Equal(map[store.DepositStatus]int{store.DepositStatusExecuted: 1, store.DepositStatusDeposited: 1}, stats.Statuses)
+	s.Equal(map[string]*big.Int{"0x0100000000000000000000000000000000000000000000000000000000000000": big.NewInt(150)}, stats.Amounts)
+}
+
+func (s *DepositStoreTestSuite) TestDepositsByTxHash() {
+	s.depositStore.TrackDeposit(s.deposit)
+
+	deposits, err := s.depositStore.DepositsByTxHash(common.HexToHash("0x2"))
+
+	s.Nil(err)
+	s.Len(deposits, 1)
+	s.Equal(uint64(3), deposits[0].DepositNonce)
+}
+
+func (s *DepositStoreTestSuite) TestDepositsByTxHash_ReindexedAfterReorg() {
+	s.depositStore.TrackDeposit(s.deposit)
+	s.deposit.Origin.TxHash = common.HexToHash("0x5")
+	s.depositStore.TrackDeposit(s.deposit)
+
+	deposits, err := s.depositStore.DepositsByTxHash(common.HexToHash("0x2"))
+	s.Nil(err)
+	s.Len(deposits, 0)
+	deposits, err = s.depositStore.DepositsByTxHash(common.HexToHash("0x5"))
+	s.Nil(err)
+	s.Len(deposits, 1)
+}
+
+func (s *DepositStoreTestSuite) TestRetract() {
+	s.depositStore.TrackDeposit(s.deposit)
+	s.depositStore.TrackProposalStatus(1, 3, 1, message.ProposalStatusExecuted)
+
+	s.depositStore.Retract([]*message.Message{s.deposit, {Source: 1, Destination: 3, DepositNonce: 1}})
+
+	d, err := s.depositStore.GetDeposit(1, 2, 3)
+	s.Nil(err)
+	s.Equal(store.DepositStatusRetracted, d.Status)
+	d, err = s.depositStore.GetDeposit(1, 3, 1)
+	s.Nil(err)
+	s.Equal(store.DepositStatusExecuted, d.Status)
+
+	s.depositStore.TrackDeposit(s.deposit)
+	d, err = s.depositStore.GetDeposit(1, 2, 3)
+	s.Nil(err)
+	s.Equal(store.DepositStatusDeposited, d.Status)
+}
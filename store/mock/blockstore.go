@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: blockstore/blockstore.go
+// Source: ./store/store.go
 
 // Package mock_blockstore is a generated GoMock package.
 package mock_blockstore
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	leveldb "github.com/syndtr/goleveldb/leveldb"
 )
 
 // MockKeyValueReaderWriter is a mock of KeyValueReaderWriter interface.
@@ -136,3 +137,240 @@ func (mr *MockKeyValueWriterMockRecorder) SetByKey(key, value interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetByKey", reflect.TypeOf((*MockKeyValueWriter)(nil).SetByKey), key, value)
 }
+
+// MockKeyValueDeleter is a mock of KeyValueDeleter interface.
+type MockKeyValueDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyValueDeleterMockRecorder
+}
+
+// MockKeyValueDeleterMockRecorder is the mock recorder for MockKeyValueDeleter.
+type MockKeyValueDeleterMockRecorder struct {
+	mock *MockKeyValueDeleter
+}
+
+// NewMockKeyValueDeleter creates a new mock instance.
+func NewMockKeyValueDeleter(ctrl *gomock.Controller) *MockKeyValueDeleter {
+	mock := &MockKeyValueDeleter{ctrl: ctrl}
+	mock.recorder = &MockKeyValueDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyValueDeleter) EXPECT() *MockKeyValueDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteByKey mocks base method.
+func (m *MockKeyValueDeleter) DeleteByKey(key []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockKeyValueDeleterMockRecorder) DeleteByKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockKeyValueDeleter)(nil).DeleteByKey), key)
+}
+
+// MockKeyValueBatchWriter is a mock of KeyValueBatchWriter interface.
+type MockKeyValueBatchWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyValueBatchWriterMockRecorder
+}
+
+// MockKeyValueBatchWriterMockRecorder is the mock recorder for MockKeyValueBatchWriter.
+type MockKeyValueBatchWriterMockRecorder struct {
+	mock *MockKeyValueBatchWriter
+}
+
+// NewMockKeyValueBatchWriter creates a new mock instance.
+func NewMockKeyValueBatchWriter(ctrl *gomock.Controller) *MockKeyValueBatchWriter {
+	mock := &MockKeyValueBatchWriter{ctrl: ctrl}
+	mock.recorder = &MockKeyValueBatchWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyValueBatchWriter) EXPECT() *MockKeyValueBatchWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteBatch mocks base method.
+func (m *MockKeyValueBatchWriter) WriteBatch(batch *leveldb.Batch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteBatch", batch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteBatch indicates an expected call of WriteBatch.
+func (mr *MockKeyValueBatchWriterMockRecorder) WriteBatch(batch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatch", reflect.TypeOf((*MockKeyValueBatchWriter)(nil).WriteBatch), batch)
+}
+
+// MockKeyValueIterator is a mock of KeyValueIterator interface.
+type MockKeyValueIterator struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyValueIteratorMockRecorder
+}
+
+// MockKeyValueIteratorMockRecorder is the mock recorder for MockKeyValueIterator.
+type MockKeyValueIteratorMockRecorder struct {
+	mock *MockKeyValueIterator
+}
+
+// NewMockKeyValueIterator creates a new mock instance.
+func NewMockKeyValueIterator(ctrl *gomock.Controller) *MockKeyValueIterator {
+	mock := &MockKeyValueIterator{ctrl: ctrl}
+	mock.recorder = &MockKeyValueIteratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyValueIterator) EXPECT() *MockKeyValueIteratorMockRecorder {
+	return m.recorder
+}
+
+// GetByPrefix mocks base method.
+func (m *MockKeyValueIterator) GetByPrefix(prefix []byte) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPrefix", prefix)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPrefix indicates an expected call of GetByPrefix.
+func (mr *MockKeyValueIteratorMockRecorder) GetByPrefix(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPrefix", reflect.TypeOf((*MockKeyValueIterator)(nil).GetByPrefix), prefix)
+}
+
+// GetByPrefixFrom mocks base method.
+func (m *MockKeyValueIterator) GetByPrefixFrom(prefix, start []byte, limit int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPrefixFrom", prefix, start, limit)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPrefixFrom indicates an expected call of GetByPrefixFrom.
+func (mr *MockKeyValueIteratorMockRecorder) GetByPrefixFrom(prefix, start, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPrefixFrom", reflect.TypeOf((*MockKeyValueIterator)(nil).GetByPrefixFrom), prefix, start, limit)
+}
+
+// MockKeyValueStore is a mock of KeyValueStore interface.
+type MockKeyValueStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyValueStoreMockRecorder
+}
+
+// MockKeyValueStoreMockRecorder is the mock recorder for MockKeyValueStore.
+type MockKeyValueStoreMockRecorder struct {
+	mock *MockKeyValueStore
+}
+
+// NewMockKeyValueStore creates a new mock instance.
+func NewMockKeyValueStore(ctrl *gomock.Controller) *MockKeyValueStore {
+	mock := &MockKeyValueStore{ctrl: ctrl}
+	mock.recorder = &MockKeyValueStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyValueStore) EXPECT() *MockKeyValueStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteByKey mocks base method.
+func (m *MockKeyValueStore) DeleteByKey(key []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockKeyValueStoreMockRecorder) DeleteByKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockKeyValueStore)(nil).DeleteByKey), key)
+}
+
+// GetByKey mocks base method.
+func (m *MockKeyValueStore) GetByKey(key []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockKeyValueStoreMockRecorder) GetByKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockKeyValueStore)(nil).GetByKey), key)
+}
+
+// GetByPrefix mocks base method.
+func (m *MockKeyValueStore) GetByPrefix(prefix []byte) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPrefix", prefix)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPrefix indicates an expected call of GetByPrefix.
+func (mr *MockKeyValueStoreMockRecorder) GetByPrefix(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPrefix", reflect.TypeOf((*MockKeyValueStore)(nil).GetByPrefix), prefix)
+}
+
+// GetByPrefixFrom mocks base method.
+func (m *MockKeyValueStore) GetByPrefixFrom(prefix, start []byte, limit int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPrefixFrom", prefix, start, limit)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPrefixFrom indicates an expected call of GetByPrefixFrom.
+func (mr *MockKeyValueStoreMockRecorder) GetByPrefixFrom(prefix, start, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPrefixFrom", reflect.TypeOf((*MockKeyValueStore)(nil).GetByPrefixFrom), prefix, start, limit)
+}
+
+// SetByKey mocks base method.
+func (m *MockKeyValueStore) SetByKey(key, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetByKey", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetByKey indicates an expected call of SetByKey.
+func (mr *MockKeyValueStoreMockRecorder) SetByKey(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetByKey", reflect.TypeOf((*MockKeyValueStore)(nil).SetByKey), key, value)
+}
+
+// WriteBatch mocks base method.
+func (m *MockKeyValueStore) WriteBatch(batch *leveldb.Batch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteBatch", batch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteBatch indicates an expected call of WriteBatch.
+func (mr *MockKeyValueStoreMockRecorder) WriteBatch(batch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatch", reflect.TypeOf((*MockKeyValueStore)(nil).WriteBatch), batch)
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/rs/zerolog/log"
+)
+
+// OutboxEntry is a message with the last block of the range it was read from
+type OutboxEntry struct {
+	Message *message.Message `json:"message"`
+	Block   *big.Int         `json:"block"`
+}
+
+// Outbox persists messages read from the source chain until the destination
+// reports a final outcome for them, so they can be redelivered after a restart.
+type Outbox struct {
+	db KeyValueStore
+}
+
+func NewOutbox(db KeyValueStore) *Outbox {
+	return &Outbox{
+		db: db,
+	}
+}
+
+// StoreMessages persists messages read up to block into the outbox.
+// Storing an already stored message overwrites it.
+func (o *Outbox) StoreMessages(block *big.Int, msgs []*message.Message) error {
+	for _, m := range msgs {
+		value, err := json.Marshal(&OutboxEntry{Message: m, Block: block})
+		if err != nil {
+			return err
+		}
+
+		err = o.db.SetByKey(outboxKey(m.Source, m.Destination, m.DepositNonce), value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarkDone removes message from the outbox once its execution finished
+func (o *Outbox) MarkDone(m *message.Message) error {
+	return o.db.DeleteByKey(outboxKey(m.Source, m.Destination, m.DepositNonce))
+}
+
+// TrackProposalStatus removes message from the outbox once the destination
+// reports its proposal as executed or cancelled
+func (o *Outbox) TrackProposalStatus(source uint8, destination uint8, depositNonce uint64, status uint8) {
+	if status != message.ProposalStatusExecuted && status != message.ProposalStatusCanceled {
+		return
+	}
+
+	err := o.db.DeleteByKey(outboxKey(source, destination, depositNonce))
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed removing message %d-%d-%d from outbox", source, destination, depositNonce)
+	}
+}
+
+// PendingMessages returns all messages from source domain that still wait for
+// a final outcome ordered by deposit nonce
+func (o *Outbox) PendingMessages(source uint8) ([]*message.Message, error) {
+	entries, err := o.entries(source)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*message.Message, len(entries))
+	for i, e := range entries {
+		msgs[i] = e.Message
+	}
+	return msgs, nil
+}
+
+// RetractMessages removes pending messages from source domain read from
+// blocks starting with fromBlock and returns them. Used when those blocks
+// are no longer part of the canonical chain.
+func (o *Outbox) RetractMessages(source uint8, fromBlock *big.Int) ([]*message.Message, error) {
+	entries, err := o.entries(source)
+	if err != nil {
+		return nil, err
+	}
+
+	retracted := make([]*message.Message, 0)
+	for _, e := range entries {
+		if e.Block.Cmp(fromBlock) == -1 {
+			continue
+		}
+
+		err := o.MarkDone(e.Message)
+		if err != nil {
+			return nil, err
+		}
+		retracted = append(retracted, e.Message)
+	}
+	return retracted, nil
+}
+
+func (o *Outbox) entries(source uint8) ([]*OutboxEntry, error) {
+	values, err := o.db.GetByPrefix(outboxPrefix(source))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*OutboxEntry, len(values))
+	for i, v := range values {
+		var e OutboxEntry
+		err := json.Unmarshal(v, &e)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = &e
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Message.DepositNonce < entries[j].Message.DepositNonce
+	})
+	return entries, nil
+}
+
+func outboxPrefix(source uint8) []byte {
+	return []byte(fmt.Sprintf("chain:%d:outbox:", source))
+}
+
+// outboxKey includes destination as deposit nonces are counted per destination
+func outboxKey(source uint8, destination uint8, depositNonce uint64) []byte {
+	key := bytes.Buffer{}
+	key.Write(outboxPrefix(source))
+	key.WriteString(fmt.Sprintf("%d:%d", destination, depositNonce))
+	return key.Bytes()
+}
@@ -0,0 +1,129 @@
+package store_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxTestSuite struct {
+	suite.Suite
+	outbox        *store.Outbox
+	keyValueStore *mock_store.MockKeyValueStore
+}
+
+func TestRunOutboxTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxTestSuite))
+}
+
+func (s *OutboxTestSuite) SetupSuite()    {}
+func (s *OutboxTestSuite) TearDownSuite() {}
+func (s *OutboxTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.keyValueStore = mock_store.NewMockKeyValueStore(gomockController)
+	s.outbox = store.NewOutbox(s.keyValueStore)
+}
+func (s *OutboxTestSuite) TearDownTest() {}
+
+func encodeEntry(m *message.Message, block int64) []byte {
+	value, _ := json.Marshal(&store.OutboxEntry{Message: m, Block: big.NewInt(block)})
+	return value
+}
+
+func (s *OutboxTestSuite) TestStoreMessages_FailedStore() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	s.keyValueStore.EXPECT().SetByKey([]byte("chain:1:outbox:2:3"), encodeEntry(m, 100)).Return(errors.New("error"))
+
+	err := s.outbox.StoreMessages(big.NewInt(100), []*message.Message{m})
+
+	s.NotNil(err)
+}
+
+func (s *OutboxTestSuite) TestStoreMessages_SuccessfulStore() {
+	m1 := &message.Message{Source: 1, Destination: 2, DepositNonce: 3}
+	m2 := &message.Message{Source: 1, Destination: 2, DepositNonce: 4, Payload: []interface{}{[]byte{1}}}
+	s.keyValueStore.EXPECT().SetByKey([]byte("chain:1:outbox:2:3"), encodeEntry(m1, 100)).Return(nil)
+	s.keyValueStore.EXPECT().SetByKey([]byte("chain:1:outbox:2:4"), encodeEntry(m2, 100)).Return(nil)
+
+	err := s.outbox.StoreMessages(big.NewInt(100), []*message.Message{m1, m2})
+
+	s.Nil(err)
+}
+
+func (s *OutboxTestSuite) TestMarkDone_DeletesMessage() {
+	s.keyValueStore.EXPECT().DeleteByKey([]byte("chain:1:outbox:2:3")).Return(nil)
+
+	err := s.outbox.MarkDone(&message.Message{Source: 1, Destination: 2, DepositNonce: 3})
+
+	s.Nil(err)
+}
+
+func (s *OutboxTestSuite) TestTrackProposalStatus_DeletesExecutedMessage() {
+	s.keyValueStore.EXPECT().DeleteByKey([]byte("chain:1:outbox:2:3")).Return(nil)
+
+	s.outbox.TrackProposalStatus(1, 2, 3, message.ProposalStatusExecuted)
+}
+
+func (s *OutboxTestSuite) TestTrackProposalStatus_KeepsPassedMessage() {
+	s.outbox.TrackProposalStatus(1, 2, 3, message.ProposalStatusPassed)
+}
+
+func (s *OutboxTestSuite) TestPendingMessages_FailedFetch() {
+	s.keyValueStore.EXPECT().GetByPrefix([]byte("chain:1:outbox:")).Return(nil, errors.New("error"))
+
+	_, err := s.outbox.PendingMessages(1)
+
+	s.NotNil(err)
+}
+
+func (s *OutboxTestSuite) TestPendingMessages_InvalidMessage() {
+	s.keyValueStore.EXPECT().GetByPrefix([]byte("chain:1:outbox:")).Return([][]byte{{1, 2, 3}}, nil)
+
+	_, err := s.outbox.PendingMessages(1)
+
+	s.NotNil(err)
+}
+
+func (s *OutboxTestSuite) TestPendingMessages_SortedByNonce() {
+	m1 := &message.Message{Source: 1, Destination: 2, DepositNonce: 10, Payload: []interface{}{[]byte{1}, []byte{2}}}
+	m2 := &message.Message{Source: 1, Destination: 2, DepositNonce: 9, Payload: []interface{}{[]byte{3}, []byte{4}}}
+	s.keyValueStore.EXPECT().GetByPrefix([]byte("chain:1:outbox:")).Return([][]byte{encodeEntry(m1, 100), encodeEntry(m2, 100)}, nil)
+
+	msgs, err := s.outbox.PendingMessages(1)
+
+	s.Nil(err)
+	s.Equal(msgs, []*message.Message{m2, m1})
+}
+
+func (s *OutboxTestSuite) TestRetractMessages_RemovesMessagesFromBlock() {
+	m1 := &message.Message{Source: 1, Destination: 2, DepositNonce: 1}
+	m2 := &message.Message{Source: 1, Destination: 2, DepositNonce: 2}
+	m3 := &message.Message{Source: 1, Destination: 3, DepositNonce: 3}
+	s.keyValueStore.EXPECT().GetByPrefix([]byte("chain:1:outbox:")).Return([][]byte{
+		encodeEntry(m1, 99), encodeEntry(m2, 100), encodeEntry(m3, 105),
+	}, nil)
+	s.keyValueStore.EXPECT().DeleteByKey([]byte("chain:1:outbox:2:2")).Return(nil)
+	s.keyValueStore.EXPECT().DeleteByKey([]byte("chain:1:outbox:3:3")).Return(nil)
+
+	msgs, err := s.outbox.RetractMessages(1, big.NewInt(100))
+
+	s.Nil(err)
+	s.Equal(msgs, []*message.Message{m2, m3})
+}
+
+func (s *OutboxTestSuite) TestRetractMessages_FailedDelete() {
+	m1 := &message.Message{Source: 1, Destination: 2, DepositNonce: 1}
+	s.keyValueStore.EXPECT().GetByPrefix([]byte("chain:1:outbox:")).Return([][]byte{encodeEntry(m1, 100)}, nil)
+	s.keyValueStore.EXPECT().DeleteByKey([]byte("chain:1:outbox:2:1")).Return(errors.New("error"))
+
+	_, err := s.outbox.RetractMessages(1, big.NewInt(100))
+
+	s.NotNil(err)
+}
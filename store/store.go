@@ -1,6 +1,10 @@
 package store
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
 
 var (
 	ErrNotFound = errors.New("key not found")
@@ -18,3 +22,27 @@ type KeyValueReader interface {
 type KeyValueWriter interface {
 	SetByKey(key []byte, value []byte) error
 }
+
+type KeyValueDeleter interface {
+	DeleteByKey(key []byte) error
+}
+
+type KeyValueBatchWriter interface {
+	// WriteBatch applies all writes and deletes of the batch atomically
+	WriteBatch(batch *leveldb.Batch) error
+}
+
+type KeyValueIterator interface {
+	// GetByPrefix returns values of all keys that start with prefix ordered by key
+	GetByPrefix(prefix []byte) ([][]byte, error)
+	// GetByPrefixFrom returns values of up to limit keys that start with prefix
+	// and are not lower than start ordered by key. Limit lower than 1 is unlimited.
+	GetByPrefixFrom(prefix []byte, start []byte, limit int) ([][]byte, error)
+}
+
+type KeyValueStore interface {
+	KeyValueReaderWriter
+	KeyValueDeleter
+	KeyValueIterator
+	KeyValueBatchWriter
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// JournaledTx is a sent transaction that is not yet included on chain
+// with hashes of all its replacements
+type JournaledTx struct {
+	Nonce        uint64          `json:"nonce"`
+	To           *common.Address `json:"to,omitempty"`
+	Value        *big.Int        `json:"value,omitempty"`
+	GasLimit     uint64          `json:"gasLimit"`
+	GasPrice     []*big.Int      `json:"gasPrice"`
+	Data         []byte          `json:"data"`
+	SubmitTime   time.Time       `json:"submitTime"`
+	CreationTime time.Time       `json:"creationTime"`
+	Hashes       []common.Hash   `json:"hashes"`
+	// Cancelled is set once the transaction was replaced with a self transfer
+	Cancelled bool `json:"cancelled,omitempty"`
+}
+
+// TxJournal persists pending transactions per domain and sender so they can
+// be monitored after the relayer restarts
+type TxJournal struct {
+	db KeyValueStore
+}
+
+func NewTxJournal(db KeyValueStore) *TxJournal {
+	return &TxJournal{
+		db: db,
+	}
+}
+
+// StoreTransaction stores transaction or replaces previous entry with the same nonce
+func (j *TxJournal) StoreTransaction(domainID uint8, from common.Address, tx *JournaledTx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	return j.db.SetByKey(txJournalKey(domainID, from, tx.Nonce), value)
+}
+
+// DeleteTransaction removes transaction that was included on chain or abandoned
+func (j *TxJournal) DeleteTransaction(domainID uint8, from common.Address, nonce uint64) error {
+	return j.db.DeleteByKey(txJournalKey(domainID, from, nonce))
+}
+
+// Transactions returns journaled transactions of sender ordered by nonce
+func (j *TxJournal) Transactions(domainID uint8, from common.Address) ([]*JournaledTx, error) {
+	values, err := j.db.GetByPrefix(txJournalPrefix(domainID, from))
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*JournaledTx, len(values))
+	for i, v := range values {
+		var tx JournaledTx
+		err := json.Unmarshal(v, &tx)
+		if err != nil {
+			return nil, err
+		}
+		txs[i] = &tx
+	}
+	return txs, nil
+}
+
+func txJournalPrefix(domainID uint8, from common.Address) []byte {
+	return []byte(fmt.Sprintf("chain:%d:txjournal:%s:", domainID, strings.ToLower(from.Hex())))
+}
+
+// txJournalKey pads nonce so keys are iterated in nonce order
+func txJournalKey(domainID uint8, from common.Address, nonce uint64) []byte {
+	return append(txJournalPrefix(domainID, from), []byte(fmt.Sprintf("%020d", nonce))...)
+}
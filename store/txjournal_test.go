@@ -0,0 +1,79 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type TxJournalTestSuite struct {
+	suite.Suite
+	db        *lvldb.LVLDB
+	txJournal *store.TxJournal
+}
+
+func TestRunTxJournalTestSuite(t *testing.T) {
+	suite.Run(t, new(TxJournalTestSuite))
+}
+
+func (s *TxJournalTestSuite) SetupTest() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	s.txJournal = store.NewTxJournal(db)
+}
+
+func (s *TxJournalTestSuite) TearDownTest() {
+	_ = s.db.Close()
+}
+
+func (s *TxJournalTestSuite) TestTransactions_OrderedByNonce() {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	err := s.txJournal.StoreTransaction(1, from, &store.JournaledTx{Nonce: 10, To: &to, GasPrice: []*big.Int{big.NewInt(1)}, Hashes: []common.Hash{{1}}})
+	s.Nil(err)
+	err = s.txJournal.StoreTransaction(1, from, &store.JournaledTx{Nonce: 9, GasPrice: []*big.Int{big.NewInt(1)}, Hashes: []common.Hash{{2}}})
+	s.Nil(err)
+	err = s.txJournal.StoreTransaction(2, from, &store.JournaledTx{Nonce: 1, Hashes: []common.Hash{{3}}})
+	s.Nil(err)
+
+	txs, err := s.txJournal.Transactions(1, from)
+
+	s.Nil(err)
+	s.Len(txs, 2)
+	s.Equal(uint64(9), txs[0].Nonce)
+	s.Equal(uint64(10), txs[1].Nonce)
+	s.Equal(to, *txs[1].To)
+	s.Equal([]*big.Int{big.NewInt(1)}, txs[1].GasPrice)
+}
+
+func (s *TxJournalTestSuite) TestStoreTransaction_ReplacesTransactionWithSameNonce() {
+	from := common.HexToAddress("0x1")
+	err := s.txJournal.StoreTransaction(1, from, &store.JournaledTx{Nonce: 1, Hashes: []common.Hash{{1}}})
+	s.Nil(err)
+	err = s.txJournal.StoreTransaction(1, from, &store.JournaledTx{Nonce: 1, Hashes: []common.Hash{{1}, {2}}})
+	s.Nil(err)
+
+	txs, err := s.txJournal.Transactions(1, from)
+
+	s.Nil(err)
+	s.Len(txs, 1)
+	s.Equal([]common.Hash{{1}, {2}}, txs[0].Hashes)
+}
+
+func (s *TxJournalTestSuite) TestDeleteTransaction() {
+	from := common.HexToAddress("0x1")
+	err := s.txJournal.StoreTransaction(1, from, &store.JournaledTx{Nonce: 1, Hashes: []common.Hash{{1}}})
+	s.Nil(err)
+
+	err = s.txJournal.DeleteTransaction(1, from, 1)
+	s.Nil(err)
+
+	txs, err := s.txJournal.Transactions(1, from)
+	s.Nil(err)
+	s.Len(txs, 0)
+}
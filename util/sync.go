@@ -0,0 +1,26 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package util
+
+import (
+	"context"
+	"sync"
+)
+
+// Wait blocks until wg counter is zero or ctx is done and returns ctx error
+// in the latter case
+func Wait(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}